@@ -0,0 +1,258 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"strings"
+)
+
+// edifactDelimiters are the service characters of an EDIFACT interchange
+type edifactDelimiters struct {
+	component, element, decimal, release, segment byte
+}
+
+// defaultEDIFACTDelimiters are used when an interchange has no UNA service string advice
+var defaultEDIFACTDelimiters = edifactDelimiters{':', '+', '.', '?', '\''}
+
+// parseEDIFACTRemittance reads an EDIFACT REMADV Remittance Advice message. Delimiters are taken from
+// the UNA service string advice when present.
+func parseEDIFACTRemittance(addenda string) (*AddendaRemittance, error) {
+	s := strings.TrimSpace(addenda)
+	d := defaultEDIFACTDelimiters
+	if strings.HasPrefix(s, "UNA") && len(s) >= 9 {
+		d = edifactDelimiters{s[3], s[4], s[5], s[6], s[8]}
+		s = s[9:]
+	}
+
+	rem := &AddendaRemittance{}
+	var invoice *AddendaInvoice
+	var adjustment *AddendaAdjustment
+	found := false
+	for _, segment := range d.split(s) {
+		if len(segment) == 0 {
+			continue
+		}
+		tag := strings.TrimSpace(segment[0][0])
+		switch tag {
+		case "UNH":
+			if edifactComponent(segment, 2, 0) != "REMADV" {
+				return nil, fmt.Errorf("message type %q: %w", edifactComponent(segment, 2, 0), ErrAddendaRemittance)
+			}
+		case "BGM":
+			found = true
+			rem.Reference = edifactComponent(segment, 2, 0)
+		case "DTM":
+			date := edifactComponent(segment, 1, 1)
+			if invoice != nil {
+				invoice.Date = date
+			} else if rem.PaymentDate == "" {
+				rem.PaymentDate = date
+			}
+		case "CUX":
+			rem.CurrencyCode = edifactComponent(segment, 1, 1)
+		case "NAD":
+			party := AddendaParty{
+				Identifier:         edifactComponent(segment, 2, 0),
+				IdentificationCode: edifactComponent(segment, 2, 2),
+				Name:               strings.Join(edifactComponents(segment, 4), " "),
+			}
+			if party.Name == "" {
+				party.Name = strings.Join(edifactComponents(segment, 3), " ")
+			}
+			switch edifactComponent(segment, 1, 0) {
+			case "PR":
+				rem.Payer = party
+			case "PE":
+				rem.Payee = party
+			}
+		case "DOC":
+			found = true
+			rem.Invoices = append(rem.Invoices, AddendaInvoice{
+				Number: edifactComponent(segment, 2, 0),
+			})
+			invoice, adjustment = &rem.Invoices[len(rem.Invoices)-1], nil
+		case "AJT":
+			adj := AddendaAdjustment{ReasonCode: edifactComponent(segment, 1, 0)}
+			if invoice != nil {
+				invoice.Adjustments = append(invoice.Adjustments, adj)
+				adjustment = &invoice.Adjustments[len(invoice.Adjustments)-1]
+			} else {
+				rem.Adjustments = append(rem.Adjustments, adj)
+				adjustment = &rem.Adjustments[len(rem.Adjustments)-1]
+			}
+		case "MOA":
+			qualifier, amount := edifactComponent(segment, 1, 0), edifactComponent(segment, 1, 1)
+			if d.decimal != '.' {
+				amount = strings.Replace(amount, string(d.decimal), ".", 1)
+			}
+			if ccy := edifactComponent(segment, 1, 2); ccy != "" && rem.CurrencyCode == "" {
+				rem.CurrencyCode = ccy
+			}
+			switch {
+			case adjustment != nil:
+				adjustment.Amount = amount
+				adjustment = nil
+			case invoice == nil && (qualifier == "9" || qualifier == "12"):
+				rem.TotalAmount = amount
+			case invoice != nil && qualifier == "12":
+				invoice.AmountPaid = amount
+			case invoice != nil && qualifier == "39":
+				invoice.GrossAmount = amount
+			case invoice != nil && qualifier == "52":
+				invoice.DiscountAmount = amount
+			}
+		}
+	}
+	if !found {
+		return nil, ErrAddendaRemittance
+	}
+	return rem, nil
+}
+
+// split breaks s into segments of elements of components, honoring the release character
+func (d edifactDelimiters) split(s string) [][][]string {
+	var segments [][][]string
+	var segment [][]string
+	var element []string
+	var value strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == d.release && i+1 < len(s):
+			i++
+			value.WriteByte(s[i])
+		case c == d.component:
+			element = append(element, value.String())
+			value.Reset()
+		case c == d.element:
+			segment = append(segment, append(element, value.String()))
+			element = nil
+			value.Reset()
+		case c == d.segment:
+			segment = append(segment, append(element, value.String()))
+			segments = append(segments, segment)
+			segment, element = nil, nil
+			value.Reset()
+		case c == '\r' || c == '\n':
+		default:
+			value.WriteByte(c)
+		}
+	}
+	if segment != nil || element != nil || value.Len() > 0 {
+		segments = append(segments, append(segment, append(element, value.String())))
+	}
+	return segments
+}
+
+// edifactComponent returns component j of element i of a segment, or an empty string when it's absent
+func edifactComponent(segment [][]string, i, j int) string {
+	if i < len(segment) && j < len(segment[i]) {
+		return strings.TrimSpace(segment[i][j])
+	}
+	return ""
+}
+
+// edifactComponents returns the non-empty components of element i of a segment
+func edifactComponents(segment [][]string, i int) []string {
+	var out []string
+	if i < len(segment) {
+		for _, c := range segment[i] {
+			if c = strings.TrimSpace(c); c != "" {
+				out = append(out, c)
+			}
+		}
+	}
+	return out
+}
+
+// formatEDIFACTRemittance writes rem as an EDIFACT REMADV message using the default delimiters
+func formatEDIFACTRemittance(rem *AddendaRemittance) string {
+	d := defaultEDIFACTDelimiters
+	var segments []string
+	add := func(elements ...[]string) {
+		// trailing empty elements are dropped
+		for len(elements) > 1 && strings.Join(elements[len(elements)-1], "") == "" {
+			elements = elements[:len(elements)-1]
+		}
+		var buf strings.Builder
+		for i, e := range elements {
+			if i > 0 {
+				buf.WriteByte(d.element)
+			}
+			for j, c := range e {
+				if j > 0 {
+					buf.WriteByte(d.component)
+				}
+				buf.WriteString(d.escape(c))
+			}
+		}
+		segments = append(segments, buf.String())
+	}
+	e := func(c ...string) []string { return c }
+
+	reference := rem.Reference
+	if reference == "" {
+		reference = "1"
+	}
+	add(e("UNH"), e("1"), e("REMADV", "D", "96A", "UN"))
+	add(e("BGM"), e("481"), e(reference), e("9"))
+	if rem.PaymentDate != "" {
+		add(e("DTM"), e("137", rem.PaymentDate, "102"))
+	}
+	for _, p := range []struct {
+		code  string
+		party AddendaParty
+	}{{"PR", rem.Payer}, {"PE", rem.Payee}} {
+		if p.party != (AddendaParty{}) {
+			id := e(p.party.Identifier)
+			if p.party.IdentificationCode != "" {
+				id = e(p.party.Identifier, "", p.party.IdentificationCode)
+			}
+			add(e("NAD"), e(p.code), id, e(), e(p.party.Name))
+		}
+	}
+	if rem.CurrencyCode != "" {
+		add(e("CUX"), e("2", rem.CurrencyCode, "11"))
+	}
+	add(e("MOA"), e("9", rem.TotalAmount))
+	adjustments := func(adjs []AddendaAdjustment) {
+		for _, adj := range adjs {
+			add(e("AJT"), e(adj.ReasonCode))
+			add(e("MOA"), e("165", adj.Amount))
+		}
+	}
+	adjustments(rem.Adjustments)
+	for _, inv := range rem.Invoices {
+		add(e("DOC"), e("380"), e(inv.Number))
+		if inv.Date != "" {
+			add(e("DTM"), e("3", inv.Date, "102"))
+		}
+		for _, moa := range []struct{ qualifier, amount string }{
+			{"12", inv.AmountPaid}, {"39", inv.GrossAmount}, {"52", inv.DiscountAmount},
+		} {
+			if moa.amount != "" {
+				add(e("MOA"), e(moa.qualifier, moa.amount))
+			}
+		}
+		adjustments(inv.Adjustments)
+	}
+	add(e("UNT"), e(fmt.Sprintf("%d", len(segments)+1)), e("1"))
+
+	return strings.Join(segments, string(d.segment)) + string(d.segment)
+}
+
+// escape prefixes delimiters in s with the release character
+func (d edifactDelimiters) escape(s string) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case d.component, d.element, d.release, d.segment:
+			buf.WriteByte(d.release)
+		}
+		buf.WriteByte(s[i])
+	}
+	return buf.String()
+}
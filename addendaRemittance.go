@@ -0,0 +1,179 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+)
+
+// maxAddendaLength is the largest Addenda an UnstructuredAddenda can carry
+const maxAddendaLength = 8994
+
+// AddendaRemittance is the remittance advice carried in UnstructuredAddenda when LocalInstrument is
+// ANSIX12format, STP820format, UNEDIFACTformat, GeneralXMLformat or ISO20022XMLformat.
+//
+// Amounts use a period as the decimal marker and dates are CCYYMMDD.
+type AddendaRemittance struct {
+	// Payer is the party remitting the payment (X12 N1*PR, EDIFACT NAD+PR, ISO 20022 Invcee)
+	Payer AddendaParty `json:"payer"`
+	// Payee is the party receiving the payment (X12 N1*PE, EDIFACT NAD+PE, ISO 20022 Invcr)
+	Payee AddendaParty `json:"payee"`
+	// CurrencyCode of the amounts
+	CurrencyCode string `json:"currencyCode,omitempty"`
+	// TotalAmount is the amount of the payment
+	TotalAmount string `json:"totalAmount,omitempty"`
+	// PaymentDate is the effective date of the payment
+	PaymentDate string `json:"paymentDate,omitempty"`
+	// Reference is the reassociation trace number or document number of the advice
+	Reference string `json:"reference,omitempty"`
+	// Invoices are the documents being paid
+	Invoices []AddendaInvoice `json:"invoices,omitempty"`
+	// Adjustments that apply to the payment rather than to an invoice
+	Adjustments []AddendaAdjustment `json:"adjustments,omitempty"`
+}
+
+// AddendaParty is a payer or payee in an AddendaRemittance
+type AddendaParty struct {
+	// Name of the party
+	Name string `json:"name,omitempty"`
+	// IdentificationCode qualifies Identifier (e.g. X12 N103 or an EDIFACT code list agency)
+	IdentificationCode string `json:"identificationCode,omitempty"`
+	// Identifier of the party
+	Identifier string `json:"identifier,omitempty"`
+}
+
+// AddendaInvoice is one document paid by an AddendaRemittance
+type AddendaInvoice struct {
+	// Number of the invoice
+	Number string `json:"number"`
+	// Date of the invoice
+	Date string `json:"date,omitempty"`
+	// AmountPaid against the invoice
+	AmountPaid string `json:"amountPaid,omitempty"`
+	// GrossAmount of the invoice
+	GrossAmount string `json:"grossAmount,omitempty"`
+	// DiscountAmount taken
+	DiscountAmount string `json:"discountAmount,omitempty"`
+	// Adjustments made to the invoice
+	Adjustments []AddendaAdjustment `json:"adjustments,omitempty"`
+}
+
+// AddendaAdjustment is an adjustment to the amount paid. Amounts that reduce what is owed are negative.
+type AddendaAdjustment struct {
+	// ReasonCode of the adjustment (X12 ADX02, EDIFACT AJT 4465 or an ISO 20022 reason)
+	ReasonCode string `json:"reasonCode,omitempty"`
+	// Amount of the adjustment
+	Amount string `json:"amount"`
+}
+
+// ParseAddendaRemittance reads the remittance advice in addenda according to localInstrumentCode:
+//   - ANSIX12format and STP820format hold an X12 820 transaction set
+//   - UNEDIFACTformat holds an EDIFACT REMADV message
+//   - GeneralXMLformat and ISO20022XMLformat hold ISO 20022 structured remittance (Strd) elements
+func ParseAddendaRemittance(localInstrumentCode, addenda string) (*AddendaRemittance, error) {
+	var rem *AddendaRemittance
+	var err error
+	switch localInstrumentCode {
+	case ANSIX12format, STP820format:
+		rem, err = parseX12Remittance(addenda)
+	case UNEDIFACTformat:
+		rem, err = parseEDIFACTRemittance(addenda)
+	case GeneralXMLformat, ISO20022XMLformat:
+		rem, err = parseXMLRemittance(addenda)
+	default:
+		return nil, fieldError("LocalInstrumentCode", ErrAddendaFormat, localInstrumentCode)
+	}
+	if err != nil {
+		return nil, fieldError("Addenda", err)
+	}
+	return rem, nil
+}
+
+// FormatAddendaRemittance writes rem in the format of localInstrumentCode. TotalAmount is the sum of
+// the invoice amounts paid when left empty. X12 values can't contain "*" or "~", which are rejected with
+// ErrAddendaSeparator.
+func FormatAddendaRemittance(localInstrumentCode string, rem *AddendaRemittance) (string, error) {
+	if rem == nil {
+		return "", fieldError("Addenda", ErrAddendaRemittance)
+	}
+	r := *rem
+	if r.TotalAmount == "" {
+		total, err := r.invoiceTotal()
+		if err != nil {
+			return "", err
+		}
+		r.TotalAmount = total
+	}
+
+	var addenda string
+	switch localInstrumentCode {
+	case ANSIX12format, STP820format:
+		var err error
+		if addenda, err = formatX12Remittance(&r); err != nil {
+			return "", err
+		}
+	case UNEDIFACTformat:
+		addenda = formatEDIFACTRemittance(&r)
+	case GeneralXMLformat, ISO20022XMLformat:
+		addenda = formatXMLRemittance(&r)
+	default:
+		return "", fieldError("LocalInstrumentCode", ErrAddendaFormat, localInstrumentCode)
+	}
+	if n := utf8.RuneCountInString(addenda); n > maxAddendaLength {
+		return "", fieldError("Addenda", ErrAddendaLength, n)
+	}
+	return addenda, nil
+}
+
+// invoiceTotal sums the AmountPaid of every invoice
+func (rem *AddendaRemittance) invoiceTotal() (string, error) {
+	total, scale := new(big.Rat), 2
+	for _, inv := range rem.Invoices {
+		if inv.AmountPaid == "" {
+			continue
+		}
+		r, s, err := parseDecimalAmount(inv.AmountPaid, '.')
+		if err != nil {
+			return "", fieldError("AmountPaid", err, inv.AmountPaid)
+		}
+		if s > scale {
+			scale = s
+		}
+		total.Add(total, r)
+	}
+	return total.FloatString(scale), nil
+}
+
+// Remittance reads the remittance advice in Addenda. See ParseAddendaRemittance.
+func (ua *UnstructuredAddenda) Remittance(localInstrumentCode string) (*AddendaRemittance, error) {
+	return ParseAddendaRemittance(localInstrumentCode, ua.Addenda)
+}
+
+// NewUnstructuredAddendaRemittance returns an UnstructuredAddenda holding rem in the format of
+// localInstrumentCode, with AddendaLength set to the length of the generated Addenda.
+func NewUnstructuredAddendaRemittance(localInstrumentCode string, rem *AddendaRemittance) (*UnstructuredAddenda, error) {
+	addenda, err := FormatAddendaRemittance(localInstrumentCode, rem)
+	if err != nil {
+		return nil, err
+	}
+	ua := NewUnstructuredAddenda()
+	ua.Addenda = addenda
+	ua.AddendaLength = fmt.Sprintf("%04d", utf8.RuneCountInString(addenda))
+	return ua, nil
+}
+
+// AddendaRemittance reads the remittance advice in the UnstructuredAddenda of fwm using its
+// LocalInstrument. A nil remittance is returned when fwm has no UnstructuredAddenda.
+func (fwm *FEDWireMessage) AddendaRemittance() (*AddendaRemittance, error) {
+	if fwm.UnstructuredAddenda == nil {
+		return nil, nil
+	}
+	if fwm.LocalInstrument == nil {
+		return nil, fieldError("LocalInstrument", ErrFieldRequired)
+	}
+	return fwm.UnstructuredAddenda.Remittance(fwm.LocalInstrument.LocalInstrumentCode)
+}
@@ -0,0 +1,180 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockAddendaRemittance returns an AddendaRemittance paying two invoices
+func mockAddendaRemittance() *AddendaRemittance {
+	return &AddendaRemittance{
+		Payer:        AddendaParty{Name: "Acme Corp", IdentificationCode: "1", Identifier: "123456789"},
+		Payee:        AddendaParty{Name: "Widgets+Co", IdentificationCode: "92", Identifier: "V100"},
+		CurrencyCode: "USD",
+		TotalAmount:  "1450.00",
+		PaymentDate:  "20240212",
+		Reference:    "TRACE001",
+		Invoices: []AddendaInvoice{
+			{
+				Number:         "INV-1",
+				Date:           "20240101",
+				AmountPaid:     "950.00",
+				GrossAmount:    "1000.00",
+				DiscountAmount: "20.00",
+				Adjustments:    []AddendaAdjustment{{ReasonCode: "01", Amount: "-30.00"}},
+			},
+			{Number: "INV-2", AmountPaid: "500.00", GrossAmount: "500.00"},
+		},
+	}
+}
+
+func TestAddendaRemittance_X12(t *testing.T) {
+	rem := mockAddendaRemittance()
+	rem.Adjustments = []AddendaAdjustment{{ReasonCode: "CS", Amount: "5.00"}}
+	for _, code := range []string{ANSIX12format, STP820format} {
+		addenda, err := FormatAddendaRemittance(code, rem)
+		require.NoError(t, err)
+		require.Equal(t, "ST*820*0001~BPR*I*1450.00*C*FWT************20240212~TRN*1*TRACE001~CUR*PR*USD~"+
+			"N1*PR*Acme Corp*1*123456789~N1*PE*Widgets+Co*92*V100~ENT*1~ADX*5.00*CS~"+
+			"RMR*IV*INV-1**950.00*1000.00*20.00~DTM*003*20240101~ADX*-30.00*01~"+
+			"RMR*IV*INV-2**500.00*500.00~SE*13*0001~", addenda)
+
+		got, err := ParseAddendaRemittance(code, addenda)
+		require.NoError(t, err)
+		require.Equal(t, rem, got)
+	}
+
+	// separators can't be escaped
+	for _, v := range []string{"Acme*Corp", "Acme~Corp"} {
+		rem := mockAddendaRemittance()
+		rem.Payer.Name = v
+		_, err := FormatAddendaRemittance(ANSIX12format, rem)
+		require.ErrorIs(t, err, ErrAddendaSeparator)
+		require.ErrorContains(t, err, v)
+	}
+	rem = mockAddendaRemittance()
+	rem.Invoices[0].Number = "INV*1"
+	_, err := FormatAddendaRemittance(STP820format, rem)
+	require.ErrorIs(t, err, ErrAddendaSeparator)
+}
+
+func TestAddendaRemittance_X12Interchange(t *testing.T) {
+	// separators are taken from the ISA header
+	isa := "ISA|00|          |00|          |ZZ|SENDER         |ZZ|RECEIVER       |240212|1200|U|00401|000000001|0|P|>\n"
+	require.Len(t, isa, x12ISALength)
+	addenda := isa + "GS|RA|SENDER|RECEIVER|20240212|1200|1|X|004010\nST|820|0001\n" +
+		"BPR|C|100.00|C|ACH\nRMR|IV|INV-9||100.00\nSE|4|0001\nGE|1|1\nIEA|1|000000001\n"
+
+	rem, err := ParseAddendaRemittance(ANSIX12format, addenda)
+	require.NoError(t, err)
+	require.Equal(t, "100.00", rem.TotalAmount)
+	require.Equal(t, []AddendaInvoice{{Number: "INV-9", AmountPaid: "100.00"}}, rem.Invoices)
+
+	_, err = ParseAddendaRemittance(ANSIX12format, "ST*810*0001~BIG*20240101*INV~SE*3*0001~")
+	require.ErrorIs(t, err, ErrAddendaRemittance)
+}
+
+func TestAddendaRemittance_EDIFACT(t *testing.T) {
+	rem := mockAddendaRemittance()
+	addenda, err := FormatAddendaRemittance(UNEDIFACTformat, rem)
+	require.NoError(t, err)
+	require.Equal(t, "UNH+1+REMADV:D:96A:UN'BGM+481+TRACE001+9'DTM+137:20240212:102'"+
+		"NAD+PR+123456789::1++Acme Corp'NAD+PE+V100::92++Widgets?+Co'CUX+2:USD:11'MOA+9:1450.00'"+
+		"DOC+380+INV-1'DTM+3:20240101:102'MOA+12:950.00'MOA+39:1000.00'MOA+52:20.00'AJT+01'MOA+165:-30.00'"+
+		"DOC+380+INV-2'MOA+12:500.00'MOA+39:500.00'UNT+18+1'", addenda)
+
+	got, err := ParseAddendaRemittance(UNEDIFACTformat, addenda)
+	require.NoError(t, err)
+	require.Equal(t, rem, got)
+
+	// UNA can change the delimiters and decimal mark
+	got, err = ParseAddendaRemittance(UNEDIFACTformat, "UNA:+,? 'UNH+1+REMADV:D:96A:UN'BGM+481+R1'MOA+9:12,50:EUR'DOC+380+A?'1'MOA+12:12,50'UNT+6+1'")
+	require.NoError(t, err)
+	require.Equal(t, &AddendaRemittance{
+		CurrencyCode: "EUR",
+		TotalAmount:  "12.50",
+		Reference:    "R1",
+		Invoices:     []AddendaInvoice{{Number: "A'1", AmountPaid: "12.50"}},
+	}, got)
+
+	_, err = ParseAddendaRemittance(UNEDIFACTformat, "UNH+1+INVOIC:D:96A:UN'BGM+380+1'UNT+3+1'")
+	require.ErrorIs(t, err, ErrAddendaRemittance)
+}
+
+func TestAddendaRemittance_XML(t *testing.T) {
+	rem := mockAddendaRemittance()
+	rem.PaymentDate = "" // not carried by RmtInf
+	for _, code := range []string{GeneralXMLformat, ISO20022XMLformat} {
+		addenda, err := FormatAddendaRemittance(code, rem)
+		require.NoError(t, err)
+		require.Contains(t, addenda, `<RfrdDocInf><Tp><CdOrPrtry><Cd>CINV</Cd></CdOrPrtry></Tp><Nb>INV-1</Nb><RltdDt>2024-01-01</RltdDt></RfrdDocInf>`)
+		require.Contains(t, addenda, `<AdjstmntAmtAndRsn><Amt Ccy="USD">30.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Rsn>01</Rsn></AdjstmntAmtAndRsn>`)
+
+		got, err := ParseAddendaRemittance(code, addenda)
+		require.NoError(t, err)
+		require.Equal(t, rem, got)
+	}
+
+	// older message versions carry the discount amount directly and namespaces are ignored
+	got, err := ParseAddendaRemittance(ISO20022XMLformat, `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.02">
+		<RmtInf><Strd><RfrdDocInf><Nb>X1</Nb></RfrdDocInf><RfrdDocAmt>
+		<DscntApldAmt Ccy="EUR">1.00</DscntApldAmt><RmtdAmt Ccy="EUR">9.00</RmtdAmt>
+		</RfrdDocAmt></Strd></RmtInf></Document>`)
+	require.NoError(t, err)
+	require.Equal(t, &AddendaRemittance{
+		CurrencyCode: "EUR",
+		TotalAmount:  "9.00",
+		Invoices:     []AddendaInvoice{{Number: "X1", AmountPaid: "9.00", DiscountAmount: "1.00"}},
+	}, got)
+
+	_, err = ParseAddendaRemittance(GeneralXMLformat, "<RmtInf><Ustrd>free text</Ustrd></RmtInf>")
+	require.ErrorIs(t, err, ErrAddendaRemittance)
+}
+
+func TestNewUnstructuredAddendaRemittance(t *testing.T) {
+	rem := mockAddendaRemittance()
+	rem.TotalAmount = ""
+
+	ua, err := NewUnstructuredAddendaRemittance(UNEDIFACTformat, rem)
+	require.NoError(t, err)
+	require.Equal(t, "0297", ua.AddendaLength)
+	require.Len(t, ua.Addenda, 297)
+	require.Contains(t, ua.Addenda, "MOA+9:1450.00'")
+
+	// the addenda survives a round trip through the tag
+	read := NewUnstructuredAddenda()
+	require.NoError(t, read.Parse(ua.String()))
+	got, err := read.Remittance(UNEDIFACTformat)
+	require.NoError(t, err)
+	require.Equal(t, "1450.00", got.TotalAmount)
+
+	_, err = NewUnstructuredAddendaRemittance(NarrativeText, rem)
+	require.ErrorIs(t, err, ErrAddendaFormat)
+
+	for i := 0; i < 500; i++ {
+		rem.Invoices = append(rem.Invoices, AddendaInvoice{Number: "INVOICE-NUMBER", AmountPaid: "1.00"})
+	}
+	_, err = NewUnstructuredAddendaRemittance(ANSIX12format, rem)
+	require.ErrorIs(t, err, ErrAddendaLength)
+}
+
+func TestFEDWireMessage_AddendaRemittance(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	rem, err := fwm.AddendaRemittance()
+	require.NoError(t, err)
+	require.Nil(t, rem)
+
+	fwm.LocalInstrument = mockLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = ANSIX12format
+	fwm.UnstructuredAddenda, err = NewUnstructuredAddendaRemittance(ANSIX12format, mockAddendaRemittance())
+	require.NoError(t, err)
+
+	rem, err = fwm.AddendaRemittance()
+	require.NoError(t, err)
+	require.Equal(t, mockAddendaRemittance(), rem)
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// x12ElementSeparator is the element separator used when no ISA segment says otherwise
+	x12ElementSeparator = "*"
+	// x12SegmentTerminator is the segment terminator used when no ISA segment says otherwise
+	x12SegmentTerminator = "~"
+	// x12ISALength is the fixed length of an ISA interchange header, including its terminator
+	x12ISALength = 106
+)
+
+// parseX12Remittance reads an X12 820 Payment Order/Remittance Advice. Separators are taken from the
+// ISA header when present, otherwise "*" and "~" (or line breaks) are expected.
+func parseX12Remittance(addenda string) (*AddendaRemittance, error) {
+	s := strings.TrimSpace(addenda)
+	elementSep, segmentTerm := x12ElementSeparator, x12SegmentTerminator
+	if strings.HasPrefix(s, "ISA") && len(s) >= x12ISALength {
+		elementSep, segmentTerm = s[3:4], s[x12ISALength-1:x12ISALength]
+	} else if !strings.Contains(s, segmentTerm) {
+		segmentTerm = "\n"
+	}
+
+	rem := &AddendaRemittance{}
+	var invoice *AddendaInvoice
+	found := false
+	for _, segment := range strings.Split(s, segmentTerm) {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			continue
+		}
+		e := strings.Split(segment, elementSep)
+		switch e[0] {
+		case "ST":
+			if x12Element(e, 1) != "820" {
+				return nil, fmt.Errorf("transaction set %q: %w", x12Element(e, 1), ErrAddendaRemittance)
+			}
+		case "BPR":
+			found = true
+			rem.TotalAmount = x12Element(e, 2)
+			rem.PaymentDate = x12Element(e, 16)
+		case "TRN":
+			rem.Reference = x12Element(e, 2)
+		case "CUR":
+			rem.CurrencyCode = x12Element(e, 2)
+		case "N1":
+			party := AddendaParty{
+				Name:               x12Element(e, 2),
+				IdentificationCode: x12Element(e, 3),
+				Identifier:         x12Element(e, 4),
+			}
+			switch x12Element(e, 1) {
+			case "PR":
+				rem.Payer = party
+			case "PE":
+				rem.Payee = party
+			}
+		case "RMR":
+			found = true
+			rem.Invoices = append(rem.Invoices, AddendaInvoice{
+				Number:         x12Element(e, 2),
+				AmountPaid:     x12Element(e, 4),
+				GrossAmount:    x12Element(e, 5),
+				DiscountAmount: x12Element(e, 6),
+			})
+			invoice = &rem.Invoices[len(rem.Invoices)-1]
+		case "DTM":
+			if invoice != nil && x12Element(e, 1) == "003" {
+				invoice.Date = x12Element(e, 2)
+			}
+		case "ADX":
+			adj := AddendaAdjustment{
+				Amount:     x12Element(e, 1),
+				ReasonCode: x12Element(e, 2),
+			}
+			if invoice != nil {
+				invoice.Adjustments = append(invoice.Adjustments, adj)
+			} else {
+				rem.Adjustments = append(rem.Adjustments, adj)
+			}
+		}
+	}
+	if !found {
+		return nil, ErrAddendaRemittance
+	}
+	return rem, nil
+}
+
+// x12Element returns element i of a segment, or an empty string when it's absent
+func x12Element(e []string, i int) string {
+	if i < len(e) {
+		return strings.TrimSpace(e[i])
+	}
+	return ""
+}
+
+// formatX12Remittance writes rem as an X12 820 transaction set using "*" and "~" as separators. X12 has no
+// release character, so values containing either are rejected rather than escaped.
+func formatX12Remittance(rem *AddendaRemittance) (string, error) {
+	var segments []string
+	var err error
+	add := func(e ...string) {
+		for _, v := range e[1:] {
+			if err == nil && strings.ContainsAny(v, x12ElementSeparator+x12SegmentTerminator) {
+				err = fieldError("Addenda", ErrAddendaSeparator, v)
+			}
+		}
+		// trailing empty elements are dropped
+		for len(e) > 1 && e[len(e)-1] == "" {
+			e = e[:len(e)-1]
+		}
+		segments = append(segments, strings.Join(e, x12ElementSeparator))
+	}
+
+	add("ST", "820", "0001")
+	add("BPR", "I", rem.TotalAmount, "C", "FWT", "", "", "", "", "", "", "", "", "", "", "", rem.PaymentDate)
+	if rem.Reference != "" {
+		add("TRN", "1", rem.Reference)
+	}
+	if rem.CurrencyCode != "" {
+		add("CUR", "PR", rem.CurrencyCode)
+	}
+	for _, p := range []struct {
+		code  string
+		party AddendaParty
+	}{{"PR", rem.Payer}, {"PE", rem.Payee}} {
+		if p.party != (AddendaParty{}) {
+			add("N1", p.code, p.party.Name, p.party.IdentificationCode, p.party.Identifier)
+		}
+	}
+	if len(rem.Invoices) > 0 || len(rem.Adjustments) > 0 {
+		add("ENT", "1")
+	}
+	for _, adj := range rem.Adjustments {
+		add("ADX", adj.Amount, adj.ReasonCode)
+	}
+	for _, inv := range rem.Invoices {
+		add("RMR", "IV", inv.Number, "", inv.AmountPaid, inv.GrossAmount, inv.DiscountAmount)
+		if inv.Date != "" {
+			add("DTM", "003", inv.Date)
+		}
+		for _, adj := range inv.Adjustments {
+			add("ADX", adj.Amount, adj.ReasonCode)
+		}
+	}
+	add("SE", fmt.Sprintf("%d", len(segments)+1), "0001")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(segments, x12SegmentTerminator) + x12SegmentTerminator, nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// xmlStructuredRemittance is an ISO 20022 StructuredRemittanceInformation (Strd) element. Elements are
+// matched on their local name so any namespace or message version is accepted.
+type xmlStructuredRemittance struct {
+	ReferredDocument struct {
+		Number      string `xml:"Nb"`
+		RelatedDate string `xml:"RltdDt"`
+	} `xml:"RfrdDocInf"`
+	ReferredAmount struct {
+		DuePayable *xmlAmount `xml:"DuePyblAmt"`
+		Discount   *struct {
+			xmlAmount
+			Amount *xmlAmount `xml:"Amt"`
+		} `xml:"DscntApldAmt"`
+		Adjustments []struct {
+			Amount               xmlAmount `xml:"Amt"`
+			CreditDebitIndicator string    `xml:"CdtDbtInd"`
+			Reason               string    `xml:"Rsn"`
+		} `xml:"AdjstmntAmtAndRsn"`
+		Remitted *xmlAmount `xml:"RmtdAmt"`
+	} `xml:"RfrdDocAmt"`
+	CreditorReference string   `xml:"CdtrRefInf>Ref"`
+	Invoicer          xmlParty `xml:"Invcr"`
+	Invoicee          xmlParty `xml:"Invcee"`
+}
+
+// xmlAmount is an ISO 20022 ActiveOrHistoricCurrencyAndAmount
+type xmlAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// xmlParty is an ISO 20022 PartyIdentification
+type xmlParty struct {
+	Name       string `xml:"Nm"`
+	OrgID      string `xml:"Id>OrgId>Othr>Id"`
+	OrgScheme  string `xml:"Id>OrgId>Othr>SchmeNm>Cd"`
+	AnyBIC     string `xml:"Id>OrgId>AnyBIC"`
+	PrivateID  string `xml:"Id>PrvtId>Othr>Id"`
+	PrivateSch string `xml:"Id>PrvtId>Othr>SchmeNm>Cd"`
+}
+
+// parseXMLRemittance reads the ISO 20022 structured remittance (Strd) elements in addenda, which may be
+// a complete message, a RmtInf element or bare Strd elements. Each Strd becomes an invoice.
+func parseXMLRemittance(addenda string) (*AddendaRemittance, error) {
+	// bare Strd elements aren't a well formed document on their own
+	dec := xml.NewDecoder(strings.NewReader("<addenda>" + addenda + "</addenda>"))
+	rem := &AddendaRemittance{}
+	var remitted []string
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "Strd" {
+			continue
+		}
+		var strd xmlStructuredRemittance
+		if err := dec.DecodeElement(&strd, &start); err != nil {
+			return nil, err
+		}
+
+		inv := AddendaInvoice{
+			Number: strings.TrimSpace(strd.ReferredDocument.Number),
+			Date:   fromISODate(strd.ReferredDocument.RelatedDate),
+		}
+		amounts := &strd.ReferredAmount
+		if a := amounts.Remitted; a != nil {
+			inv.AmountPaid = rem.xmlAmount(*a)
+			remitted = append(remitted, inv.AmountPaid)
+		}
+		if a := amounts.DuePayable; a != nil {
+			inv.GrossAmount = rem.xmlAmount(*a)
+		}
+		if a := amounts.Discount; a != nil {
+			// DiscountAmountAndType wraps the amount in Amt since version 4 of the messages
+			if a.Amount != nil {
+				inv.DiscountAmount = rem.xmlAmount(*a.Amount)
+			} else {
+				inv.DiscountAmount = rem.xmlAmount(a.xmlAmount)
+			}
+		}
+		for _, adj := range amounts.Adjustments {
+			amount := rem.xmlAmount(adj.Amount)
+			if strings.TrimSpace(adj.CreditDebitIndicator) == CreditIndicator {
+				amount = "-" + amount
+			}
+			inv.Adjustments = append(inv.Adjustments, AddendaAdjustment{
+				ReasonCode: strings.TrimSpace(adj.Reason),
+				Amount:     amount,
+			})
+		}
+		rem.Invoices = append(rem.Invoices, inv)
+
+		if rem.Reference == "" {
+			rem.Reference = strings.TrimSpace(strd.CreditorReference)
+		}
+		if rem.Payee == (AddendaParty{}) {
+			rem.Payee = strd.Invoicer.party()
+		}
+		if rem.Payer == (AddendaParty{}) {
+			rem.Payer = strd.Invoicee.party()
+		}
+	}
+	if len(rem.Invoices) == 0 {
+		return nil, ErrAddendaRemittance
+	}
+	if len(remitted) == len(rem.Invoices) {
+		total, err := rem.invoiceTotal()
+		if err != nil {
+			return nil, err
+		}
+		rem.TotalAmount = total
+	}
+	return rem, nil
+}
+
+// xmlAmount returns the value of a, taking the currency of rem from the first amount
+func (rem *AddendaRemittance) xmlAmount(a xmlAmount) string {
+	if rem.CurrencyCode == "" {
+		rem.CurrencyCode = strings.TrimSpace(a.Currency)
+	}
+	return strings.TrimSpace(a.Value)
+}
+
+// party converts p into an AddendaParty
+func (p xmlParty) party() AddendaParty {
+	party := AddendaParty{Name: strings.TrimSpace(p.Name)}
+	switch {
+	case p.AnyBIC != "":
+		party.IdentificationCode, party.Identifier = "BIC", p.AnyBIC
+	case p.OrgID != "":
+		party.IdentificationCode, party.Identifier = p.OrgScheme, p.OrgID
+	case p.PrivateID != "":
+		party.IdentificationCode, party.Identifier = p.PrivateSch, p.PrivateID
+	}
+	party.IdentificationCode = strings.TrimSpace(party.IdentificationCode)
+	party.Identifier = strings.TrimSpace(party.Identifier)
+	return party
+}
+
+// formatXMLRemittance writes rem as an ISO 20022 RmtInf element with one Strd per invoice. Adjustments
+// that apply to the whole payment are carried on the first invoice.
+func formatXMLRemittance(rem *AddendaRemittance) string {
+	var buf strings.Builder
+	text := func(name, value string) {
+		if value == "" {
+			return
+		}
+		buf.WriteString("<" + name + ">")
+		xml.EscapeText(&buf, []byte(value))
+		buf.WriteString("</" + name + ">")
+	}
+	amount := func(name, value string) {
+		if value == "" {
+			return
+		}
+		buf.WriteString("<" + name + ` Ccy="` + rem.CurrencyCode + `">` + value + "</" + name + ">")
+	}
+	party := func(name string, p AddendaParty) {
+		if p == (AddendaParty{}) {
+			return
+		}
+		buf.WriteString("<" + name + ">")
+		text("Nm", p.Name)
+		if p.Identifier != "" {
+			buf.WriteString("<Id><OrgId><Othr>")
+			text("Id", p.Identifier)
+			if p.IdentificationCode != "" {
+				buf.WriteString("<SchmeNm>")
+				text("Cd", p.IdentificationCode)
+				buf.WriteString("</SchmeNm>")
+			}
+			buf.WriteString("</Othr></OrgId></Id>")
+		}
+		buf.WriteString("</" + name + ">")
+	}
+
+	invoices := rem.Invoices
+	if len(invoices) == 0 {
+		invoices = []AddendaInvoice{{AmountPaid: rem.TotalAmount}}
+	}
+	buf.WriteString("<RmtInf>")
+	for i, inv := range invoices {
+		adjustments := inv.Adjustments
+		if i == 0 {
+			adjustments = append(append([]AddendaAdjustment{}, rem.Adjustments...), adjustments...)
+		}
+
+		buf.WriteString("<Strd>")
+		if inv.Number != "" {
+			buf.WriteString("<RfrdDocInf><Tp><CdOrPrtry><Cd>CINV</Cd></CdOrPrtry></Tp>")
+			text("Nb", inv.Number)
+			text("RltdDt", toISODate(inv.Date))
+			buf.WriteString("</RfrdDocInf>")
+		}
+		buf.WriteString("<RfrdDocAmt>")
+		amount("DuePyblAmt", inv.GrossAmount)
+		if inv.DiscountAmount != "" {
+			buf.WriteString("<DscntApldAmt>")
+			amount("Amt", inv.DiscountAmount)
+			buf.WriteString("</DscntApldAmt>")
+		}
+		for _, adj := range adjustments {
+			indicator, value := DebitIndicator, adj.Amount
+			if strings.HasPrefix(value, "-") {
+				indicator, value = CreditIndicator, value[1:]
+			}
+			buf.WriteString("<AdjstmntAmtAndRsn>")
+			amount("Amt", value)
+			text("CdtDbtInd", indicator)
+			text("Rsn", adj.ReasonCode)
+			buf.WriteString("</AdjstmntAmtAndRsn>")
+		}
+		amount("RmtdAmt", inv.AmountPaid)
+		buf.WriteString("</RfrdDocAmt>")
+		if rem.Reference != "" {
+			buf.WriteString("<CdtrRefInf>")
+			text("Ref", rem.Reference)
+			buf.WriteString("</CdtrRefInf>")
+		}
+		party("Invcr", rem.Payee)
+		party("Invcee", rem.Payer)
+		buf.WriteString("</Strd>")
+	}
+	buf.WriteString("</RmtInf>")
+	return buf.String()
+}
+
+// toISODate converts a CCYYMMDD date into the YYYY-MM-DD form used by ISO 20022
+func toISODate(date string) string {
+	if len(date) != 8 {
+		return date
+	}
+	return date[:4] + "-" + date[4:6] + "-" + date[6:]
+}
+
+// fromISODate converts a YYYY-MM-DD date into CCYYMMDD
+func fromISODate(date string) string {
+	return strings.ReplaceAll(strings.TrimSpace(date), "-", "")
+}
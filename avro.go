@@ -0,0 +1,114 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/wire/wirepb"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+var (
+	avroCodec     *goavro.Codec
+	avroCodecErr  error
+	avroCodecOnce sync.Once
+)
+
+func fileAvroCodec() (*goavro.Codec, error) {
+	avroCodecOnce.Do(func() {
+		avroCodec, avroCodecErr = goavro.NewCodec(wirepb.AvroSchema)
+	})
+	return avroCodec, avroCodecErr
+}
+
+// MarshalAvro encodes f in the Avro binary encoding, following the schema of wirepb/wire.avsc.
+func (f *File) MarshalAvro() ([]byte, error) {
+	codec, err := fileAvroCodec()
+	if err != nil {
+		return nil, fmt.Errorf("problem reading Avro schema: %v", err)
+	}
+	bs, err := codec.BinaryFromNative(nil, avroNative(reflect.ValueOf(f).Elem()))
+	if err != nil {
+		return nil, fmt.Errorf("problem encoding File: %v", err)
+	}
+	return bs, nil
+}
+
+// UnmarshalAvro decodes data, a File in the Avro binary encoding, into f.
+func (f *File) UnmarshalAvro(data []byte) error {
+	codec, err := fileAvroCodec()
+	if err != nil {
+		return fmt.Errorf("problem reading Avro schema: %v", err)
+	}
+	native, _, err := codec.NativeFromBinary(data)
+	if err != nil {
+		return fmt.Errorf("problem reading File: %v", err)
+	}
+
+	// The tags record their tag when they're read from JSON, so the decoded record takes that route.
+	bs, err := json.Marshal(avroUnwrap(native))
+	if err != nil {
+		return fmt.Errorf("problem reading File: %v", err)
+	}
+	file := NewFile()
+	if err := json.Unmarshal(bs, file); err != nil {
+		return fmt.Errorf("problem reading File: %v", err)
+	}
+	*f = *file
+	return nil
+}
+
+// avroNative returns v, a struct of the wire package, as the native form goavro encodes. Optional
+// records are unions of null and the record, named after its struct.
+func avroNative(v reflect.Value) map[string]interface{} {
+	out := make(map[string]interface{})
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() || sf.Anonymous || sf.Tag.Get("json") == "-" {
+			continue
+		}
+		fv := v.Field(i)
+		switch {
+		case sf.Type.Kind() == reflect.Ptr:
+			if fv.IsNil() {
+				out[jsonName(sf)] = nil
+			} else {
+				out[jsonName(sf)] = goavro.Union("moov.wire.v1."+sf.Type.Elem().Name(), avroNative(fv.Elem()))
+			}
+		case sf.Type.Kind() == reflect.Struct:
+			out[jsonName(sf)] = avroNative(fv)
+		default:
+			out[jsonName(sf)] = fv.Interface()
+		}
+	}
+	return out
+}
+
+// avroUnwrap replaces the unions in native, decoded by goavro, with their values
+func avroUnwrap(native interface{}) interface{} {
+	m, ok := native.(map[string]interface{})
+	if !ok {
+		return native
+	}
+	if len(m) == 1 {
+		for name, value := range m {
+			if strings.HasPrefix(name, "moov.wire.v1.") {
+				return avroUnwrap(value)
+			}
+		}
+	}
+	out := make(map[string]interface{}, len(m))
+	for name, value := range m {
+		out[name] = avroUnwrap(value)
+	}
+	return out
+}
@@ -0,0 +1,29 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile__Avro(t *testing.T) {
+	for name, file := range readJSONTestdata(t) {
+		t.Run(name, func(t *testing.T) {
+			bs, err := file.MarshalAvro()
+			require.NoError(t, err)
+
+			var out File
+			require.NoError(t, out.UnmarshalAvro(bs))
+			require.Equal(t, file, &out)
+		})
+	}
+}
+
+func TestFile__UnmarshalAvroInvalid(t *testing.T) {
+	var file File
+	require.Error(t, file.UnmarshalAvro([]byte{0x02}))
+}
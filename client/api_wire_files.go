@@ -16,6 +16,7 @@ import (
 	_ioutil "io/ioutil"
 	_nethttp "net/http"
 	_neturl "net/url"
+	"os"
 	"strings"
 )
 
@@ -107,11 +108,164 @@ func (a *WireFilesApiService) AddFEDWireMessageToFile(ctx _context.Context, file
 	return localVarHTTPResponse, nil
 }
 
+// ConvertWireMessageOpts Optional parameters for the method 'ConvertWireMessage'
+type ConvertWireMessageOpts struct {
+	XRequestID                   optional.String
+	SkipMandatoryIMAD            optional.Bool
+	AllowMissingSenderSupplied   optional.Bool
+	SkipRemittanceReconciliation optional.Bool
+	RepairAddendaLength          optional.Bool
+	NormalizeCharset             optional.Bool
+	Strict                       optional.Bool
+	Format                       optional.String
+	Newline                      optional.Bool
+}
+
+/*
+ConvertWireMessage Convert file without storing it
+Converts an uploaded Wire file, or one in JSON, to Wire text or JSON without storing it. Query parameters configure the FedWireMessage validation options of both text and JSON files.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param wireFile Content of the Wire file (in json or raw text)
+  - @param optional nil or *ConvertWireMessageOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "SkipMandatoryIMAD" (optional.Bool) -  Optional flag to skip mandatory IMAD validation
+  - @param "AllowMissingSenderSupplied" (optional.Bool) -  Optional flag to allow SenderSupplied to be nil, which is generally the case in incoming files.
+  - @param "SkipRemittanceReconciliation" (optional.Bool) -  Optional flag to skip checking that structured remittance amounts add up to ActualAmountPaid and the amount of the transfer
+  - @param "RepairAddendaLength" (optional.Bool) -  Optional flag to recompute the AddendaLength of UnstructuredAddenda from the addenda read instead of rejecting a mismatch
+  - @param "NormalizeCharset" (optional.Bool) -  Optional flag to transliterate text into the Fedwire character set and uppercase code fields before validation. Each change is reported as a warning, and values which no longer fit their field are rejected.
+  - @param "Strict" (optional.Bool) -  Optional flag to reject JSON keys which aren't fields of a tag, keys whose case differs from the field's and values of the wrong type, rather than ignoring them. Each is listed in the ValidationProblem with its JSON pointer. See GET /files/schema.
+  - @param "Format" (optional.String) -  Optional format to convert the file to. Either fixed or variable length text, or json.
+  - @param "Newline" (optional.Bool) -  Optional new line flag to have new line or no new line in converted text
+
+@return string
+*/
+func (a *WireFilesApiService) ConvertWireMessage(ctx _context.Context, wireFile WireFile, localVarOptionals *ConvertWireMessageOpts) (string, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  string
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/convert"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	if localVarOptionals != nil && localVarOptionals.SkipMandatoryIMAD.IsSet() {
+		localVarQueryParams.Add("skipMandatoryIMAD", parameterToString(localVarOptionals.SkipMandatoryIMAD.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.AllowMissingSenderSupplied.IsSet() {
+		localVarQueryParams.Add("allowMissingSenderSupplied", parameterToString(localVarOptionals.AllowMissingSenderSupplied.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipRemittanceReconciliation.IsSet() {
+		localVarQueryParams.Add("skipRemittanceReconciliation", parameterToString(localVarOptionals.SkipRemittanceReconciliation.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.RepairAddendaLength.IsSet() {
+		localVarQueryParams.Add("repairAddendaLength", parameterToString(localVarOptionals.RepairAddendaLength.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.NormalizeCharset.IsSet() {
+		localVarQueryParams.Add("normalizeCharset", parameterToString(localVarOptionals.NormalizeCharset.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Strict.IsSet() {
+		localVarQueryParams.Add("strict", parameterToString(localVarOptionals.Strict.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Format.IsSet() {
+		localVarQueryParams.Add("format", parameterToString(localVarOptionals.Format.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Newline.IsSet() {
+		localVarQueryParams.Add("newline", parameterToString(localVarOptionals.Newline.Value(), ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json", "text/plain"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json", "text/plain"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	// body params
+	localVarPostBody = &wireFile
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v string
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ValidationProblem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 // CreateWireFileOpts Optional parameters for the method 'CreateWireFile'
 type CreateWireFileOpts struct {
-	XRequestID                 optional.String
-	SkipMandatoryIMAD          optional.Bool
-	AllowMissingSenderSupplied optional.Bool
+	XRequestID                   optional.String
+	SkipMandatoryIMAD            optional.Bool
+	AllowMissingSenderSupplied   optional.Bool
+	SkipRemittanceReconciliation optional.Bool
+	RepairAddendaLength          optional.Bool
+	NormalizeCharset             optional.Bool
+	Strict                       optional.Bool
 }
 
 /*
@@ -123,6 +277,10 @@ Upload a new Wire file, or create one from JSON. When uploading a file, query pa
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
   - @param "SkipMandatoryIMAD" (optional.Bool) -  Optional flag to skip mandatory IMAD validation
   - @param "AllowMissingSenderSupplied" (optional.Bool) -  Optional flag to allow SenderSupplied to be nil, which is generally the case in incoming files.
+  - @param "SkipRemittanceReconciliation" (optional.Bool) -  Optional flag to skip checking that structured remittance amounts add up to ActualAmountPaid and the amount of the transfer
+  - @param "RepairAddendaLength" (optional.Bool) -  Optional flag to recompute the AddendaLength of UnstructuredAddenda from the addenda read instead of rejecting a mismatch
+  - @param "NormalizeCharset" (optional.Bool) -  Optional flag to transliterate text into the Fedwire character set and uppercase code fields before validation. Each change is reported as a warning, and values which no longer fit their field are rejected.
+  - @param "Strict" (optional.Bool) -  Optional flag to reject JSON keys which aren't fields of a tag, keys whose case differs from the field's and values of the wrong type, rather than ignoring them. Each is listed in the ValidationProblem with its JSON pointer. See GET /files/schema.
 
 @return WireFile
 */
@@ -149,6 +307,18 @@ func (a *WireFilesApiService) CreateWireFile(ctx _context.Context, wireFile Wire
 	if localVarOptionals != nil && localVarOptionals.AllowMissingSenderSupplied.IsSet() {
 		localVarQueryParams.Add("allowMissingSenderSupplied", parameterToString(localVarOptionals.AllowMissingSenderSupplied.Value(), ""))
 	}
+	if localVarOptionals != nil && localVarOptionals.SkipRemittanceReconciliation.IsSet() {
+		localVarQueryParams.Add("skipRemittanceReconciliation", parameterToString(localVarOptionals.SkipRemittanceReconciliation.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.RepairAddendaLength.IsSet() {
+		localVarQueryParams.Add("repairAddendaLength", parameterToString(localVarOptionals.RepairAddendaLength.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.NormalizeCharset.IsSet() {
+		localVarQueryParams.Add("normalizeCharset", parameterToString(localVarOptionals.NormalizeCharset.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Strict.IsSet() {
+		localVarQueryParams.Add("strict", parameterToString(localVarOptionals.Strict.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json", "text/plain"}
 
@@ -159,7 +329,7 @@ func (a *WireFilesApiService) CreateWireFile(ctx _context.Context, wireFile Wire
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"application/json"}
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -203,6 +373,16 @@ func (a *WireFilesApiService) CreateWireFile(ctx _context.Context, wireFile Wire
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
+			var v ValidationProblem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
 			var v Error
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
@@ -303,39 +483,77 @@ func (a *WireFilesApiService) DeleteWireFileByID(ctx _context.Context, fileID st
 	return localVarHTTPResponse, nil
 }
 
-// GetWireFileByIDOpts Optional parameters for the method 'GetWireFileByID'
-type GetWireFileByIDOpts struct {
-	XRequestID optional.String
+// ExportWireFilesOpts Optional parameters for the method 'ExportWireFiles'
+type ExportWireFilesOpts struct {
+	XRequestID           optional.String
+	Output               optional.String
+	Format               optional.String
+	Newline              optional.Bool
+	BusinessFunctionCode optional.String
+	TypeCode             optional.String
+	SubTypeCode          optional.String
+	InputCycleDate       optional.String
+	Status               optional.String
 }
 
 /*
-GetWireFileByID Retrieve file
-Get the details of an existing File using the unique File identifier that was returned upon creation.
+ExportWireFiles Export files
+Render every file matching the listing filters in one response, as concatenated FED Wire text, JSON Lines or a zip archive with one FED Wire file per entry. When the approval workflow is enabled only approved or released files can be exported as FED Wire text or zip.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-  - @param fileID File ID
-  - @param optional nil or *GetWireFileByIDOpts - Optional Parameters:
+  - @param optional nil or *ExportWireFilesOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "Output" (optional.String) -  Format of the export
+  - @param "Format" (optional.String) -  Optional file type to get file as fixed length or variable length type
+  - @param "Newline" (optional.Bool) -  Optional new line flag to have new line or no new line
+  - @param "BusinessFunctionCode" (optional.String) -  Only include files with this business function code
+  - @param "TypeCode" (optional.String) -  Only include files with this type code
+  - @param "SubTypeCode" (optional.String) -  Only include files with this subtype code
+  - @param "InputCycleDate" (optional.String) -  Only include files with this IMAD input cycle date (YYYYMMDD)
+  - @param "Status" (optional.String) -  Only include files in this approval workflow state. Requires the approval workflow to be enabled.
 
-@return WireFile
+@return string
 */
-func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID string, localVarOptionals *GetWireFileByIDOpts) (WireFile, *_nethttp.Response, error) {
+func (a *WireFilesApiService) ExportWireFiles(ctx _context.Context, localVarOptionals *ExportWireFilesOpts) (string, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  WireFile
+		localVarReturnValue  string
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/files/{fileID}"
-	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+	localVarPath := a.client.cfg.BasePath + "/files/export"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
+	if localVarOptionals != nil && localVarOptionals.Output.IsSet() {
+		localVarQueryParams.Add("output", parameterToString(localVarOptionals.Output.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Format.IsSet() {
+		localVarQueryParams.Add("format", parameterToString(localVarOptionals.Format.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Newline.IsSet() {
+		localVarQueryParams.Add("newline", parameterToString(localVarOptionals.Newline.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.BusinessFunctionCode.IsSet() {
+		localVarQueryParams.Add("businessFunctionCode", parameterToString(localVarOptionals.BusinessFunctionCode.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.TypeCode.IsSet() {
+		localVarQueryParams.Add("typeCode", parameterToString(localVarOptionals.TypeCode.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SubTypeCode.IsSet() {
+		localVarQueryParams.Add("subTypeCode", parameterToString(localVarOptionals.SubTypeCode.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.InputCycleDate.IsSet() {
+		localVarQueryParams.Add("inputCycleDate", parameterToString(localVarOptionals.InputCycleDate.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Status.IsSet() {
+		localVarQueryParams.Add("status", parameterToString(localVarOptionals.Status.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -346,7 +564,7 @@ func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID strin
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"application/json"}
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/x-ndjson", "application/zip", "text/plain"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -378,7 +596,17 @@ func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID strin
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 200 {
-			var v WireFile
+			var v string
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Error
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -402,48 +630,43 @@ func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID strin
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-// GetWireFileContentsOpts Optional parameters for the method 'GetWireFileContents'
-type GetWireFileContentsOpts struct {
+// GetWireFileByIDOpts Optional parameters for the method 'GetWireFileByID'
+type GetWireFileByIDOpts struct {
 	XRequestID optional.String
-	Format     optional.String
-	Newline    optional.Bool
+	Redact     optional.Bool
 }
 
 /*
-GetWireFileContents Get file contents
-Assembles the existing file, computes sequence numbers and totals. Returns plaintext file.
+GetWireFileByID Retrieve file
+Get the details of an existing File using the unique File identifier that was returned upon creation.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param fileID File ID
-  - @param optional nil or *GetWireFileContentsOpts - Optional Parameters:
+  - @param optional nil or *GetWireFileByIDOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
-  - @param "Format" (optional.String) -  Optional file type to get file as fixed length or variable length type
-  - @param "Newline" (optional.Bool) -  Optional new line flag to have new line or no new line
+  - @param "Redact" (optional.Bool) -  Optional flag to mask the account numbers and other identifiers of the parties to their last four characters and hide their names, addresses, contact details and free text about them
 
-@return string
+@return WireFile
 */
-func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID string, localVarOptionals *GetWireFileContentsOpts) (string, *_nethttp.Response, error) {
+func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID string, localVarOptionals *GetWireFileByIDOpts) (WireFile, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  string
+		localVarReturnValue  WireFile
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/contents"
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}"
 	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
-	if localVarOptionals != nil && localVarOptionals.Format.IsSet() {
-		localVarQueryParams.Add("format", parameterToString(localVarOptionals.Format.Value(), ""))
-	}
-	if localVarOptionals != nil && localVarOptionals.Newline.IsSet() {
-		localVarQueryParams.Add("newline", parameterToString(localVarOptionals.Newline.Value(), ""))
+	if localVarOptionals != nil && localVarOptionals.Redact.IsSet() {
+		localVarQueryParams.Add("redact", parameterToString(localVarOptionals.Redact.Value(), ""))
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -455,7 +678,7 @@ func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID s
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"text/plain"}
+	localVarHTTPHeaderAccepts := []string{"application/json"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -487,7 +710,7 @@ func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID s
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 200 {
-			var v string
+			var v WireFile
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -511,37 +734,49 @@ func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID s
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-// GetWireFilesOpts Optional parameters for the method 'GetWireFiles'
-type GetWireFilesOpts struct {
+// GetWireFileContentsOpts Optional parameters for the method 'GetWireFileContents'
+type GetWireFileContentsOpts struct {
 	XRequestID optional.String
+	Format     optional.String
+	Newline    optional.Bool
 }
 
 /*
-GetWireFiles List files
-List all Wire files created with the Wire service. These files are not persisted through multiple runs of the service.
+GetWireFileContents Get file contents
+Assembles the existing file, computes sequence numbers and totals. Returns plaintext file.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-  - @param optional nil or *GetWireFilesOpts - Optional Parameters:
+  - @param fileID File ID
+  - @param optional nil or *GetWireFileContentsOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "Format" (optional.String) -  Optional file type to get file as fixed length or variable length type
+  - @param "Newline" (optional.Bool) -  Optional new line flag to have new line or no new line
 
-@return []WireFile
+@return string
 */
-func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptionals *GetWireFilesOpts) ([]WireFile, *_nethttp.Response, error) {
+func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID string, localVarOptionals *GetWireFileContentsOpts) (string, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  []WireFile
+		localVarReturnValue  string
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/files"
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/contents"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
+	if localVarOptionals != nil && localVarOptionals.Format.IsSet() {
+		localVarQueryParams.Add("format", parameterToString(localVarOptionals.Format.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Newline.IsSet() {
+		localVarQueryParams.Add("newline", parameterToString(localVarOptionals.Newline.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -552,7 +787,7 @@ func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptiona
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"application/json"}
+	localVarHTTPHeaderAccepts := []string{"text/plain"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -584,13 +819,14 @@ func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptiona
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 200 {
-			var v []WireFile
+			var v string
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
 			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -607,22 +843,32 @@ func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptiona
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// GetWireFileSchemaOpts Optional parameters for the method 'GetWireFileSchema'
+type GetWireFileSchemaOpts struct {
+	XRequestID optional.String
+}
+
 /*
-Ping Ping Wire service
-Check if the Wire service is running.
+GetWireFileSchema Get JSON Schema of files
+Get the JSON Schema (draft 2020-12) of the files created and returned as JSON. It&#39;s generated from the structs of the wire package and rejects the same JSON as the strict flag of createWireFile.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param optional nil or *GetWireFileSchemaOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+
+@return interface{}
 */
-func (a *WireFilesApiService) Ping(ctx _context.Context) (*_nethttp.Response, error) {
+func (a *WireFilesApiService) GetWireFileSchema(ctx _context.Context, localVarOptionals *GetWireFileSchemaOpts) (interface{}, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
+		localVarReturnValue  interface{}
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/ping"
+	localVarPath := a.client.cfg.BasePath + "/files/schema"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
@@ -638,27 +884,30 @@ func (a *WireFilesApiService) Ping(ctx _context.Context) (*_nethttp.Response, er
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{}
+	localVarHTTPHeaderAccepts := []string{"application/schema+json"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
 	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
 	if err != nil {
-		return nil, err
+		return localVarReturnValue, nil, err
 	}
 
 	localVarHTTPResponse, err := a.client.callAPI(r)
 	if err != nil || localVarHTTPResponse == nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	if err != nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
@@ -666,12 +915,705 @@ func (a *WireFilesApiService) Ping(ctx _context.Context) (*_nethttp.Response, er
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		return localVarHTTPResponse, newErr
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v interface{}
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// GetWireFileWorkflowOpts Optional parameters for the method 'GetWireFileWorkflow'
+type GetWireFileWorkflowOpts struct {
+	XRequestID optional.String
+}
+
+/*
+GetWireFileWorkflow Get file workflow
+Get the maker-checker approval state of a File. Only available when &#x60;WIRE_APPROVAL_WORKFLOW&#x60; is enabled.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param fileID File ID
+  - @param optional nil or *GetWireFileWorkflowOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+
+@return FileWorkflow
+*/
+func (a *WireFilesApiService) GetWireFileWorkflow(ctx _context.Context, fileID string, localVarOptionals *GetWireFileWorkflowOpts) (FileWorkflow, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  FileWorkflow
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/workflow"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v FileWorkflow
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// GetWireFilesOpts Optional parameters for the method 'GetWireFiles'
+type GetWireFilesOpts struct {
+	XRequestID           optional.String
+	BusinessFunctionCode optional.String
+	TypeCode             optional.String
+	SubTypeCode          optional.String
+	InputCycleDate       optional.String
+	Status               optional.String
+}
+
+/*
+GetWireFiles List files
+List all Wire files created with the Wire service, ordered by IMAD. These files are not persisted through multiple runs of the service. Query parameters narrow down the listing.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param optional nil or *GetWireFilesOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "BusinessFunctionCode" (optional.String) -  Only include files with this business function code
+  - @param "TypeCode" (optional.String) -  Only include files with this type code
+  - @param "SubTypeCode" (optional.String) -  Only include files with this subtype code
+  - @param "InputCycleDate" (optional.String) -  Only include files with this IMAD input cycle date (YYYYMMDD)
+  - @param "Status" (optional.String) -  Only include files in this approval workflow state. Requires the approval workflow to be enabled.
+
+@return []WireFile
+*/
+func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptionals *GetWireFilesOpts) ([]WireFile, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []WireFile
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	if localVarOptionals != nil && localVarOptionals.BusinessFunctionCode.IsSet() {
+		localVarQueryParams.Add("businessFunctionCode", parameterToString(localVarOptionals.BusinessFunctionCode.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.TypeCode.IsSet() {
+		localVarQueryParams.Add("typeCode", parameterToString(localVarOptionals.TypeCode.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SubTypeCode.IsSet() {
+		localVarQueryParams.Add("subTypeCode", parameterToString(localVarOptionals.SubTypeCode.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.InputCycleDate.IsSet() {
+		localVarQueryParams.Add("inputCycleDate", parameterToString(localVarOptionals.InputCycleDate.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Status.IsSet() {
+		localVarQueryParams.Add("status", parameterToString(localVarOptionals.Status.Value(), ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v []WireFile
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// ImportWireCSVOpts Optional parameters for the method 'ImportWireCSV'
+type ImportWireCSVOpts struct {
+	XRequestID                   optional.String
+	SkipMandatoryIMAD            optional.Bool
+	AllowMissingSenderSupplied   optional.Bool
+	SkipRemittanceReconciliation optional.Bool
+	RepairAddendaLength          optional.Bool
+	NormalizeCharset             optional.Bool
+}
+
+/*
+ImportWireCSV Import CSV
+Create a message from each row of a CSV file, such as a payment run exported from a spreadsheet. A mapping, in YAML or JSON, maps the header of each column to the path of a FEDWireMessage field (e.g. beneficiary.personal.name) and sets defaults for every message and templates of fields for each business function code. Each row is validated and stored on its own, so invalid rows are reported with their line without preventing the valid ones from being created. Query parameters configure the FedWireMessage validation options when the mapping has no validateOptions.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param mapping YAML or JSON with columns (header to field path), optional defaults (field path to value), templates (business function code to field paths and values) and validateOptions. It may also be uploaded as a file.
+  - @param file CSV file whose first row is the header
+  - @param optional nil or *ImportWireCSVOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "SkipMandatoryIMAD" (optional.Bool) -  Optional flag to skip mandatory IMAD validation
+  - @param "AllowMissingSenderSupplied" (optional.Bool) -  Optional flag to allow SenderSupplied to be nil, which is generally the case in incoming files.
+  - @param "SkipRemittanceReconciliation" (optional.Bool) -  Optional flag to skip checking that structured remittance amounts add up to ActualAmountPaid and the amount of the transfer
+  - @param "RepairAddendaLength" (optional.Bool) -  Optional flag to recompute the AddendaLength of UnstructuredAddenda from the addenda read instead of rejecting a mismatch
+  - @param "NormalizeCharset" (optional.Bool) -  Optional flag to transliterate text into the Fedwire character set and uppercase code fields before validation. Each change is reported as a warning, and values which no longer fit their field are rejected.
+
+@return ImportReport
+*/
+func (a *WireFilesApiService) ImportWireCSV(ctx _context.Context, mapping string, file *os.File, localVarOptionals *ImportWireCSVOpts) (ImportReport, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  ImportReport
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/import/csv"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	if localVarOptionals != nil && localVarOptionals.SkipMandatoryIMAD.IsSet() {
+		localVarQueryParams.Add("skipMandatoryIMAD", parameterToString(localVarOptionals.SkipMandatoryIMAD.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.AllowMissingSenderSupplied.IsSet() {
+		localVarQueryParams.Add("allowMissingSenderSupplied", parameterToString(localVarOptionals.AllowMissingSenderSupplied.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipRemittanceReconciliation.IsSet() {
+		localVarQueryParams.Add("skipRemittanceReconciliation", parameterToString(localVarOptionals.SkipRemittanceReconciliation.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.RepairAddendaLength.IsSet() {
+		localVarQueryParams.Add("repairAddendaLength", parameterToString(localVarOptionals.RepairAddendaLength.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.NormalizeCharset.IsSet() {
+		localVarQueryParams.Add("normalizeCharset", parameterToString(localVarOptionals.NormalizeCharset.Value(), ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"multipart/form-data"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	localVarFormParams.Add("mapping", parameterToString(mapping, ""))
+	localVarFormFileName = "file"
+	localVarFile := file
+	if localVarFile != nil {
+		fbs, _ := _ioutil.ReadAll(localVarFile)
+		localVarFileBytes = fbs
+		localVarFileName = localVarFile.Name()
+		localVarFile.Close()
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v ImportReport
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// ImportWireFilesOpts Optional parameters for the method 'ImportWireFiles'
+type ImportWireFilesOpts struct {
+	XRequestID                   optional.String
+	SkipMandatoryIMAD            optional.Bool
+	AllowMissingSenderSupplied   optional.Bool
+	SkipRemittanceReconciliation optional.Bool
+	RepairAddendaLength          optional.Bool
+	NormalizeCharset             optional.Bool
+}
+
+/*
+ImportWireFiles Import files
+Upload many messages at once as a Fedwire text stream (each message starting with a {1500} tag), a JSON array of Wire files or a zip archive of .txt and .json files. Each message is parsed and stored on its own, so invalid messages are reported without preventing the valid ones from being created. Query parameters configure the FedWireMessage validation options for text and JSON messages. Uploads are limited to 50MB, and zip archives to 10,000 entries and 50MB once decompressed.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param wireFile Messages to import
+  - @param optional nil or *ImportWireFilesOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "SkipMandatoryIMAD" (optional.Bool) -  Optional flag to skip mandatory IMAD validation
+  - @param "AllowMissingSenderSupplied" (optional.Bool) -  Optional flag to allow SenderSupplied to be nil, which is generally the case in incoming files.
+  - @param "SkipRemittanceReconciliation" (optional.Bool) -  Optional flag to skip checking that structured remittance amounts add up to ActualAmountPaid and the amount of the transfer
+  - @param "RepairAddendaLength" (optional.Bool) -  Optional flag to recompute the AddendaLength of UnstructuredAddenda from the addenda read instead of rejecting a mismatch
+  - @param "NormalizeCharset" (optional.Bool) -  Optional flag to transliterate text into the Fedwire character set and uppercase code fields before validation. Each change is reported as a warning, and values which no longer fit their field are rejected.
+
+@return ImportReport
+*/
+func (a *WireFilesApiService) ImportWireFiles(ctx _context.Context, wireFile []WireFile, localVarOptionals *ImportWireFilesOpts) (ImportReport, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  ImportReport
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/import"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	if localVarOptionals != nil && localVarOptionals.SkipMandatoryIMAD.IsSet() {
+		localVarQueryParams.Add("skipMandatoryIMAD", parameterToString(localVarOptionals.SkipMandatoryIMAD.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.AllowMissingSenderSupplied.IsSet() {
+		localVarQueryParams.Add("allowMissingSenderSupplied", parameterToString(localVarOptionals.AllowMissingSenderSupplied.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipRemittanceReconciliation.IsSet() {
+		localVarQueryParams.Add("skipRemittanceReconciliation", parameterToString(localVarOptionals.SkipRemittanceReconciliation.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.RepairAddendaLength.IsSet() {
+		localVarQueryParams.Add("repairAddendaLength", parameterToString(localVarOptionals.RepairAddendaLength.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.NormalizeCharset.IsSet() {
+		localVarQueryParams.Add("normalizeCharset", parameterToString(localVarOptionals.NormalizeCharset.Value(), ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json", "text/plain", "application/zip"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	// body params
+	localVarPostBody = &wireFile
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v ImportReport
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+/*
+Ping Ping Wire service
+Check if the Wire service is running.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+*/
+func (a *WireFilesApiService) Ping(ctx _context.Context) (*_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/ping"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
 	}
 
 	return localVarHTTPResponse, nil
 }
 
+// TransitionWireFileOpts Optional parameters for the method 'TransitionWireFile'
+type TransitionWireFileOpts struct {
+	XRequestID         optional.String
+	WorkflowTransition optional.Interface
+}
+
+/*
+TransitionWireFile Move file through the approval workflow
+Submit a draft File for approval, approve or reject a submitted File, or release an approved File. The approver must not be the creator or submitter of the File, Files without a recorded creator can&#39;t be approved, and Files whose amount meets a configured threshold need more than one distinct approver. Only available when &#x60;WIRE_APPROVAL_WORKFLOW&#x60; is enabled.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param xUserID Identity of the user performing the transition
+  - @param fileID File ID
+  - @param transition Workflow transition to perform
+  - @param optional nil or *TransitionWireFileOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "WorkflowTransition" (optional.Interface of WorkflowTransition) -
+
+@return FileWorkflow
+*/
+func (a *WireFilesApiService) TransitionWireFile(ctx _context.Context, xUserID string, fileID string, transition string, localVarOptionals *TransitionWireFileOpts) (FileWorkflow, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  FileWorkflow
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/{transition}"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"transition"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", transition)), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	localVarHeaderParams["X-User-ID"] = parameterToString(xUserID, "")
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	// body params
+	if localVarOptionals != nil && localVarOptionals.WorkflowTransition.IsSet() {
+		localVarOptionalWorkflowTransition, localVarOptionalWorkflowTransitionok := localVarOptionals.WorkflowTransition.Value().(WorkflowTransition)
+		if !localVarOptionalWorkflowTransitionok {
+			return localVarReturnValue, nil, reportError("workflowTransition should be WorkflowTransition")
+		}
+		localVarPostBody = &localVarOptionalWorkflowTransition
+	}
+
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v FileWorkflow
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 // ValidateWireFileOpts Optional parameters for the method 'ValidateWireFile'
 type ValidateWireFileOpts struct {
 	XRequestID optional.String
@@ -679,22 +1621,22 @@ type ValidateWireFileOpts struct {
 
 /*
 ValidateWireFile Validate file
-Validates the existing file. You need only supply the unique File identifier that was returned upon creation.
+Validates the existing file. You need only supply the unique File identifier that was returned upon creation. Every error is returned along with warnings about things which don&#39;t make the file invalid, such as text truncated to fit a tag or a SenderReference used by another file on the same cycle date.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param fileID File ID
   - @param optional nil or *ValidateWireFileOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
 
-@return WireFile
+@return ValidationResult
 */
-func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID string, localVarOptionals *ValidateWireFileOpts) (WireFile, *_nethttp.Response, error) {
+func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID string, localVarOptionals *ValidateWireFileOpts) (ValidationResult, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  WireFile
+		localVarReturnValue  ValidationResult
 	)
 
 	// create path and map variables
@@ -715,7 +1657,7 @@ func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID stri
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"application/json"}
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -747,7 +1689,147 @@ func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID stri
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 200 {
-			var v WireFile
+			var v ValidationResult
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ValidationProblem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// ValidateWireMessageOpts Optional parameters for the method 'ValidateWireMessage'
+type ValidateWireMessageOpts struct {
+	XRequestID                   optional.String
+	SkipMandatoryIMAD            optional.Bool
+	AllowMissingSenderSupplied   optional.Bool
+	SkipRemittanceReconciliation optional.Bool
+	RepairAddendaLength          optional.Bool
+	NormalizeCharset             optional.Bool
+	Strict                       optional.Bool
+}
+
+/*
+ValidateWireMessage Validate file without storing it
+Validates an uploaded Wire file, or one in JSON, without storing it. Query parameters configure the FedWireMessage validation options of both text and JSON files. Every error is returned along with warnings.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param wireFile Content of the Wire file (in json or raw text)
+  - @param optional nil or *ValidateWireMessageOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "SkipMandatoryIMAD" (optional.Bool) -  Optional flag to skip mandatory IMAD validation
+  - @param "AllowMissingSenderSupplied" (optional.Bool) -  Optional flag to allow SenderSupplied to be nil, which is generally the case in incoming files.
+  - @param "SkipRemittanceReconciliation" (optional.Bool) -  Optional flag to skip checking that structured remittance amounts add up to ActualAmountPaid and the amount of the transfer
+  - @param "RepairAddendaLength" (optional.Bool) -  Optional flag to recompute the AddendaLength of UnstructuredAddenda from the addenda read instead of rejecting a mismatch
+  - @param "NormalizeCharset" (optional.Bool) -  Optional flag to transliterate text into the Fedwire character set and uppercase code fields before validation. Each change is reported as a warning, and values which no longer fit their field are rejected.
+  - @param "Strict" (optional.Bool) -  Optional flag to reject JSON keys which aren't fields of a tag, keys whose case differs from the field's and values of the wrong type, rather than ignoring them. Each is listed in the ValidationProblem with its JSON pointer. See GET /files/schema.
+
+@return ValidationResult
+*/
+func (a *WireFilesApiService) ValidateWireMessage(ctx _context.Context, wireFile WireFile, localVarOptionals *ValidateWireMessageOpts) (ValidationResult, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  ValidationResult
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/validate"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	if localVarOptionals != nil && localVarOptionals.SkipMandatoryIMAD.IsSet() {
+		localVarQueryParams.Add("skipMandatoryIMAD", parameterToString(localVarOptionals.SkipMandatoryIMAD.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.AllowMissingSenderSupplied.IsSet() {
+		localVarQueryParams.Add("allowMissingSenderSupplied", parameterToString(localVarOptionals.AllowMissingSenderSupplied.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipRemittanceReconciliation.IsSet() {
+		localVarQueryParams.Add("skipRemittanceReconciliation", parameterToString(localVarOptionals.SkipRemittanceReconciliation.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.RepairAddendaLength.IsSet() {
+		localVarQueryParams.Add("repairAddendaLength", parameterToString(localVarOptionals.RepairAddendaLength.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.NormalizeCharset.IsSet() {
+		localVarQueryParams.Add("normalizeCharset", parameterToString(localVarOptionals.NormalizeCharset.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Strict.IsSet() {
+		localVarQueryParams.Add("strict", parameterToString(localVarOptionals.Strict.Value(), ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json", "text/plain"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	// body params
+	localVarPostBody = &wireFile
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v ValidationResult
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -756,6 +1838,15 @@ func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID stri
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ValidationProblem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
@@ -45,6 +45,8 @@ type APIClient struct {
 
 	// API Services
 
+	TemplatesApi *TemplatesApiService
+
 	WireFilesApi *WireFilesApiService
 }
 
@@ -64,6 +66,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.TemplatesApi = (*TemplatesApiService)(&c.common)
 	c.WireFilesApi = (*WireFilesApiService)(&c.common)
 
 	return c
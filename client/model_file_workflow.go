@@ -0,0 +1,36 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// FileWorkflow struct for FileWorkflow
+type FileWorkflow struct {
+	// File ID
+	FileID string `json:"fileID,omitempty"`
+	// Approval state of the File
+	Status string `json:"status,omitempty"`
+	// User who created the File
+	CreatedBy string `json:"createdBy,omitempty"`
+	// User who submitted the File for approval
+	SubmittedBy string `json:"submittedBy,omitempty"`
+	// Number of distinct approvals needed before the File is approved
+	RequiredApprovals int32          `json:"requiredApprovals,omitempty"`
+	Approvals         []FileApproval `json:"approvals,omitempty"`
+	// User who rejected the File
+	RejectedBy string `json:"rejectedBy,omitempty"`
+	// Reason the File was rejected
+	RejectionReason string `json:"rejectionReason,omitempty"`
+	// User who released the File
+	ReleasedBy string    `json:"releasedBy,omitempty"`
+	UpdatedAt  time.Time `json:"updatedAt,omitempty"`
+}
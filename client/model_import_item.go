@@ -0,0 +1,24 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ImportItem struct for ImportItem
+type ImportItem struct {
+	// Zip entry the message was read from
+	Source string `json:"source,omitempty"`
+	// Line of the upload (or zip entry) where the message starts
+	Line int32 `json:"line,omitempty"`
+	// ID of the created File
+	FileID string `json:"fileID,omitempty"`
+	// Why the message could not be imported. Line numbers within the error are relative to the start of the message.
+	Error string `json:"error,omitempty"`
+	// Fields changed by normalizeCharset
+	Warnings []Normalization `json:"warnings,omitempty"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ImportReport struct for ImportReport
+type ImportReport struct {
+	// Number of files created
+	Created int32 `json:"created,omitempty"`
+	// Number of messages which could not be imported
+	Failed int32        `json:"failed,omitempty"`
+	Items  []ImportItem `json:"items,omitempty"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"os"
+)
+
+// InlineObject struct for InlineObject
+type InlineObject struct {
+	// YAML or JSON with columns (header to field path), optional defaults (field path to value), templates (business function code to field paths and values) and validateOptions. It may also be uploaded as a file.
+	Mapping string `json:"mapping"`
+	// CSV file whose first row is the header
+	File *os.File `json:"file"`
+}
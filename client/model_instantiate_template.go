@@ -0,0 +1,16 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// InstantiateTemplate struct for InstantiateTemplate
+type InstantiateTemplate struct {
+	// Value of each placeholder, by name
+	Values map[string]string `json:"values,omitempty"`
+}
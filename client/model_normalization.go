@@ -0,0 +1,24 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// Normalization struct for Normalization
+type Normalization struct {
+	// Tag number of the field
+	Tag string `json:"tag,omitempty"`
+	// JSON path of the field within the FEDWireMessage
+	Field string `json:"field,omitempty"`
+	// Value before normalization
+	Original string `json:"original,omitempty"`
+	// Value after normalization
+	Normalized string `json:"normalized,omitempty"`
+	// Set when the normalized value is longer than the field can hold
+	Overflow bool `json:"overflow,omitempty"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// Template struct for Template
+type Template struct {
+	// Template ID
+	Id             string         `json:"id,omitempty"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description,omitempty"`
+	FedWireMessage FedWireMessage `json:"fedWireMessage"`
+	// Names of the placeholders in the template
+	Placeholders []string `json:"placeholders,omitempty"`
+}
@@ -15,4 +15,10 @@ type ValidateOptions struct {
 	SkipMandatoryIMAD bool `json:"skipMandatoryIMAD,omitempty"`
 	// Allow FedWireMessage.SenderSupplied to be nil
 	AllowMissingSenderSupplied bool `json:"allowMissingSenderSupplied,omitempty"`
+	// Skip checking that structured remittance amounts add up to ActualAmountPaid and the amount of the transfer
+	SkipRemittanceReconciliation bool `json:"skipRemittanceReconciliation,omitempty"`
+	// Recompute the AddendaLength of UnstructuredAddenda from the addenda read instead of rejecting a mismatch
+	RepairAddendaLength bool `json:"repairAddendaLength,omitempty"`
+	// Transliterate text read into the Fedwire character set and uppercase code fields before validation
+	NormalizeCharset bool `json:"normalizeCharset,omitempty"`
 }
@@ -0,0 +1,28 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ValidationIssue struct for ValidationIssue
+type ValidationIssue struct {
+	// Stable code of the rule broken by the field, made of the tag number, the initials of the field (TAG for the whole tag) and the rule
+	Code string `json:"code,omitempty"`
+	// Tag number of the field
+	Tag string `json:"tag,omitempty"`
+	// JSON path of the field
+	Field string `json:"field,omitempty"`
+	// JSON pointer (RFC 6901) of the field within the File
+	Pointer string `json:"pointer,omitempty"`
+	// Rule broken
+	Rule string `json:"rule,omitempty"`
+	// English description of the issue
+	Message string `json:"message,omitempty"`
+	// Value of the field
+	Value interface{} `json:"value,omitempty"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ValidationProblem RFC 7807 problem details listing every error which makes a file invalid
+type ValidationProblem struct {
+	// Identifies the problem type and links to the rule codes
+	Type   string `json:"type,omitempty"`
+	Title  string `json:"title,omitempty"`
+	Status int32  `json:"status,omitempty"`
+	// The first error, which makes the file invalid
+	Detail string `json:"detail,omitempty"`
+	// The first error, the same as detail
+	Error  string            `json:"error,omitempty"`
+	Errors []ValidationIssue `json:"errors,omitempty"`
+	// Warnings about things which don't make the file invalid, returned when validating a stored file
+	Warnings []ValidationIssue `json:"warnings,omitempty"`
+}
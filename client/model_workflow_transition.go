@@ -0,0 +1,18 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// WorkflowTransition struct for WorkflowTransition
+type WorkflowTransition struct {
+	// Optional comment recorded with an approval
+	Comment string `json:"comment,omitempty"`
+	// Reason recorded with a rejection
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,607 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+)
+
+// role grants access to a group of HTTP routes
+type role string
+
+const (
+	roleRead    role = "read"
+	roleWrite   role = "write"
+	roleApprove role = "approve"
+)
+
+var (
+	// errNoCredentials is returned by an authenticator when the request doesn't carry its kind of credentials
+	errNoCredentials = errors.New("no credentials provided")
+
+	errUnauthorized = errors.New("unauthorized")
+	errForbidden    = errors.New("forbidden")
+)
+
+// principal is the authenticated caller of a request
+type principal struct {
+	UserID   string
+	TenantID string
+	Roles    []role
+}
+
+func (p *principal) hasRole(want role) bool {
+	for i := range p.Roles {
+		if p.Roles[i] == want {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+func withPrincipal(ctx context.Context, p *principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func principalFrom(ctx context.Context) *principal {
+	p, _ := ctx.Value(principalKey{}).(*principal)
+	return p
+}
+
+// getTenantID returns the tenant of the authenticated caller. When authentication is disabled
+// every request belongs to the default (empty) tenant.
+func getTenantID(r *http.Request) string {
+	if p := principalFrom(r.Context()); p != nil {
+		return p.TenantID
+	}
+	return ""
+}
+
+// getUserID returns the authenticated caller. The X-User-ID header is only trusted when
+// authentication is disabled.
+func getUserID(r *http.Request) string {
+	if p := principalFrom(r.Context()); p != nil {
+		return p.UserID
+	}
+	return moovhttp.GetUserID(r)
+}
+
+// authenticator identifies the caller of an HTTP request
+type authenticator interface {
+	authenticate(r *http.Request) (*principal, error)
+}
+
+// authenticators tries each authenticator in order until one recognizes the request's credentials
+type authenticators []authenticator
+
+func (as authenticators) authenticate(r *http.Request) (*principal, error) {
+	for i := range as {
+		p, err := as[i].authenticate(r)
+		if errors.Is(err, errNoCredentials) {
+			continue
+		}
+		return p, err
+	}
+	return nil, errNoCredentials
+}
+
+// authMiddleware rejects requests which can't be authenticated and stores the principal
+// of authenticated requests in their context. /ping and CORS preflight requests are not authenticated.
+func authMiddleware(logger log.Logger, auth authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "OPTIONS" || r.URL.Path == "/ping" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			p, err := auth.authenticate(r)
+			if err != nil {
+				logger.Set("requestID", log.String(moovhttp.GetRequestID(r))).LogErrorf("authentication failed: %v", err)
+				authProblem(w, http.StatusUnauthorized, errUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(withPrincipal(r.Context(), p)))
+		})
+	}
+}
+
+// requireRole only calls next if the authenticated caller has want. Requests are let through when
+// authentication is disabled.
+func requireRole(want role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if p := principalFrom(r.Context()); p != nil && !p.hasRole(want) {
+			authProblem(w, http.StatusForbidden, fmt.Errorf("%w: %s role required", errForbidden, want))
+			return
+		}
+		next(w, r)
+	}
+}
+
+func authProblem(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
+
+func parseRoles(values []string) ([]role, error) {
+	var out []role
+	for _, v := range values {
+		switch r := role(strings.ToLower(strings.TrimSpace(v))); r {
+		case roleRead, roleWrite, roleApprove:
+			out = append(out, r)
+		case "":
+		default:
+			return nil, fmt.Errorf("unknown role %q", v)
+		}
+	}
+	return out, nil
+}
+
+// identity is a configured caller for the API key and mTLS authenticators
+type identity struct {
+	Key     string   `json:"key,omitempty"`
+	Subject string   `json:"subject,omitempty"`
+	UserID  string   `json:"userID"`
+	Tenant  string   `json:"tenant"`
+	Roles   []string `json:"roles"`
+}
+
+func readIdentities(path string) ([]identity, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var out []identity
+	if err := json.Unmarshal(bs, &out); err != nil {
+		return nil, fmt.Errorf("problem reading %s: %v", path, err)
+	}
+	return out, nil
+}
+
+// principal returns the caller id describes. Like JWTs without a sub or tenant, identities without a user
+// or tenant are rejected so they can't reach the default tenant's files or pass as another approver.
+func (id identity) principal() (*principal, error) {
+	if id.UserID == "" {
+		return nil, errors.New("identity is missing a userID")
+	}
+	if id.Tenant == "" {
+		return nil, errors.New("identity is missing a tenant")
+	}
+	roles, err := parseRoles(id.Roles)
+	if err != nil {
+		return nil, err
+	}
+	return &principal{
+		UserID:   id.UserID,
+		TenantID: id.Tenant,
+		Roles:    roles,
+	}, nil
+}
+
+// apiKeyAuthenticator accepts static keys from the X-API-Key header or an
+// "Authorization: Bearer" header which isn't a JWT.
+type apiKeyAuthenticator struct {
+	keys map[[sha256.Size]byte]*principal
+}
+
+func newAPIKeyAuthenticator(identities []identity) (*apiKeyAuthenticator, error) {
+	auth := &apiKeyAuthenticator{
+		keys: make(map[[sha256.Size]byte]*principal),
+	}
+	for _, id := range identities {
+		if id.Key == "" {
+			return nil, errors.New("API key identity is missing a key")
+		}
+		p, err := id.principal()
+		if err != nil {
+			return nil, err
+		}
+		auth.keys[sha256.Sum256([]byte(id.Key))] = p
+	}
+	return auth, nil
+}
+
+func (a *apiKeyAuthenticator) authenticate(r *http.Request) (*principal, error) {
+	key := r.Header.Get("X-API-Key")
+	if key == "" {
+		if token := bearerToken(r); token != "" && strings.Count(token, ".") != 2 {
+			key = token
+		}
+	}
+	if key == "" {
+		return nil, errNoCredentials
+	}
+	// compare digests in constant time so lookups don't leak key prefixes
+	digest := sha256.Sum256([]byte(key))
+	for k, p := range a.keys {
+		if subtle.ConstantTimeCompare(k[:], digest[:]) == 1 {
+			return p, nil
+		}
+	}
+	return nil, errors.New("unknown API key")
+}
+
+func bearerToken(r *http.Request) string {
+	v := r.Header.Get("Authorization")
+	if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+		return strings.TrimSpace(v[7:])
+	}
+	return ""
+}
+
+// mtlsAuthenticator maps verified client certificates to identities by their
+// subject common name or DNS names.
+type mtlsAuthenticator struct {
+	subjects map[string]*principal
+}
+
+func newMTLSAuthenticator(identities []identity) (*mtlsAuthenticator, error) {
+	auth := &mtlsAuthenticator{
+		subjects: make(map[string]*principal),
+	}
+	for _, id := range identities {
+		if id.Subject == "" {
+			return nil, errors.New("mTLS identity is missing a subject")
+		}
+		if id.UserID == "" {
+			id.UserID = id.Subject
+		}
+		p, err := id.principal()
+		if err != nil {
+			return nil, fmt.Errorf("mTLS identity %s: %v", id.Subject, err)
+		}
+		auth.subjects[id.Subject] = p
+	}
+	return auth, nil
+}
+
+func (a *mtlsAuthenticator) authenticate(r *http.Request) (*principal, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, errNoCredentials
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	if p, ok := a.subjects[cert.Subject.CommonName]; ok {
+		return p, nil
+	}
+	for _, name := range cert.DNSNames {
+		if p, ok := a.subjects[name]; ok {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown client certificate %q", cert.Subject.CommonName)
+}
+
+// jwtAuthenticator verifies RS256/RS384/RS512/ES256/ES384 bearer tokens against keys
+// read from a local JWKS file.
+type jwtAuthenticator struct {
+	keys map[string]crypto.PublicKey
+
+	issuer      string
+	audience    string
+	tenantClaim string
+	rolesClaim  string
+	leeway      time.Duration
+	now         func() time.Time
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func newJWTAuthenticator(jwksPath string) (*jwtAuthenticator, error) {
+	bs, err := os.ReadFile(jwksPath)
+	if err != nil {
+		return nil, err
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(bs, &jwks); err != nil {
+		return nil, fmt.Errorf("problem reading JWKS %s: %v", jwksPath, err)
+	}
+	auth := &jwtAuthenticator{
+		keys:        make(map[string]crypto.PublicKey),
+		tenantClaim: "tenant",
+		rolesClaim:  "roles",
+		leeway:      30 * time.Second,
+		now:         time.Now,
+	}
+	for _, k := range jwks.Keys {
+		pub, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("JWKS key %q: %v", k.Kid, err)
+		}
+		auth.keys[k.Kid] = pub
+	}
+	if len(auth.keys) == 0 {
+		return nil, fmt.Errorf("no keys found in JWKS %s", jwksPath)
+	}
+	return auth, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	decode := func(s string) (*big.Int, error) {
+		bs, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(bs), nil
+	}
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func (a *jwtAuthenticator) authenticate(r *http.Request) (*principal, error) {
+	token := bearerToken(r)
+	if token == "" || strings.Count(token, ".") != 2 {
+		return nil, errNoCredentials
+	}
+	claims, err := a.verify(token)
+	if err != nil {
+		return nil, err
+	}
+	return a.principal(claims)
+}
+
+// verify checks the token's signature and time claims and returns its claims
+func (a *jwtAuthenticator) verify(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid JWT header: %v", err)
+	}
+	key, ok := a.keys[header.Kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown JWT key %q", header.Kid)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT signature: %v", err)
+	}
+	if err := verifyJWTSignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid JWT claims: %v", err)
+	}
+
+	now := a.now()
+	if exp, ok := claims["exp"].(float64); !ok || now.After(time.Unix(int64(exp), 0).Add(a.leeway)) {
+		return nil, errors.New("JWT is expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(a.leeway).Before(time.Unix(int64(nbf), 0)) {
+		return nil, errors.New("JWT is not yet valid")
+	}
+	if a.issuer != "" && claims["iss"] != a.issuer {
+		return nil, fmt.Errorf("unexpected JWT issuer %v", claims["iss"])
+	}
+	if a.audience != "" && !containsClaim(claims["aud"], a.audience) {
+		return nil, fmt.Errorf("unexpected JWT audience %v", claims["aud"])
+	}
+	return claims, nil
+}
+
+func (a *jwtAuthenticator) principal(claims map[string]interface{}) (*principal, error) {
+	sub, _ := claims["sub"].(string)
+	tenant, _ := claims[a.tenantClaim].(string)
+	if sub == "" || tenant == "" {
+		return nil, fmt.Errorf("JWT is missing sub or %s claims", a.tenantClaim)
+	}
+
+	var values []string
+	switch v := claims[a.rolesClaim].(type) {
+	case string:
+		values = strings.Fields(v)
+	case []interface{}:
+		for i := range v {
+			if s, ok := v[i].(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	roles, err := parseRoles(values)
+	if err != nil {
+		return nil, err
+	}
+	return &principal{
+		UserID:   sub,
+		TenantID: tenant,
+		Roles:    roles,
+	}, nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	bs, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
+
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported JWT algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			break
+		}
+		if err := rsa.VerifyPKCS1v15(pub, hash, digest, sig); err != nil {
+			return errors.New("invalid JWT signature")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			break
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid JWT signature")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("invalid JWT signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("JWT algorithm %q does not match key", alg)
+}
+
+func containsClaim(claim interface{}, want string) bool {
+	switch v := claim.(type) {
+	case string:
+		return v == want
+	case []interface{}:
+		for i := range v {
+			if v[i] == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newAuthenticatorFromEnv reads the WIRE_AUTH_* environment variables. A nil authenticator
+// is returned when no authentication method is configured.
+func newAuthenticatorFromEnv() (authenticator, error) {
+	var out authenticators
+
+	if path := os.Getenv("WIRE_AUTH_API_KEYS_FILE"); path != "" {
+		identities, err := readIdentities(path)
+		if err != nil {
+			return nil, err
+		}
+		auth, err := newAPIKeyAuthenticator(identities)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, auth)
+	}
+
+	if path := os.Getenv("WIRE_AUTH_JWKS_FILE"); path != "" {
+		auth, err := newJWTAuthenticator(path)
+		if err != nil {
+			return nil, err
+		}
+		auth.issuer = os.Getenv("WIRE_AUTH_JWT_ISSUER")
+		auth.audience = os.Getenv("WIRE_AUTH_JWT_AUDIENCE")
+		if claim := os.Getenv("WIRE_AUTH_JWT_TENANT_CLAIM"); claim != "" {
+			auth.tenantClaim = claim
+		}
+		if claim := os.Getenv("WIRE_AUTH_JWT_ROLES_CLAIM"); claim != "" {
+			auth.rolesClaim = claim
+		}
+		out = append(out, auth)
+	}
+
+	if path := os.Getenv("WIRE_AUTH_MTLS_IDENTITIES_FILE"); path != "" {
+		identities, err := readIdentities(path)
+		if err != nil {
+			return nil, err
+		}
+		auth, err := newMTLSAuthenticator(identities)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, auth)
+	}
+
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// readClientCAs returns the pool of CAs which sign client certificates for mTLS
+func readClientCAs(path string) (*x509.CertPool, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bs) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,298 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func b64(bs []byte) string {
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+func signTestJWT(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := b64(header) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		require.NoError(t, err)
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	}
+	return signed + "." + b64(sig)
+}
+
+func writeTestJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	t.Helper()
+
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa",
+				"n":   b64(rsaKey.N.Bytes()),
+				"e":   b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec",
+				"crv": "P-256",
+				"x":   b64(ecKey.X.Bytes()),
+				"y":   b64(ecKey.Y.Bytes()),
+			},
+		},
+	}
+	bs, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, bs, 0600))
+	return path
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	auth, err := newJWTAuthenticator(writeTestJWKS(t, rsaKey, ecKey))
+	require.NoError(t, err)
+	auth.issuer = "https://issuer.example.com"
+	auth.audience = "wire"
+
+	claims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"sub":    "jdoe",
+			"tenant": "acme",
+			"roles":  []string{"read", "write"},
+			"iss":    "https://issuer.example.com",
+			"aud":    []string{"wire"},
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		}
+	}
+	request := func(token string) *http.Request {
+		req := httptest.NewRequest("GET", "/files", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		return req
+	}
+
+	t.Run("RS256", func(t *testing.T) {
+		p, err := auth.authenticate(request(signTestJWT(t, "RS256", "rsa", rsaKey, claims())))
+		require.NoError(t, err)
+		require.Equal(t, &principal{UserID: "jdoe", TenantID: "acme", Roles: []role{roleRead, roleWrite}}, p)
+	})
+
+	t.Run("ES256", func(t *testing.T) {
+		c := claims()
+		c["roles"] = "approve"
+		p, err := auth.authenticate(request(signTestJWT(t, "ES256", "ec", ecKey, c)))
+		require.NoError(t, err)
+		require.Equal(t, []role{roleApprove}, p.Roles)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		c := claims()
+		c["exp"] = time.Now().Add(-time.Hour).Unix()
+		_, err := auth.authenticate(request(signTestJWT(t, "RS256", "rsa", rsaKey, c)))
+		require.ErrorContains(t, err, "expired")
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		c := claims()
+		c["aud"] = "other"
+		_, err := auth.authenticate(request(signTestJWT(t, "RS256", "rsa", rsaKey, c)))
+		require.ErrorContains(t, err, "audience")
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		_, err = auth.authenticate(request(signTestJWT(t, "RS256", "rsa", other, claims())))
+		require.ErrorContains(t, err, "signature")
+	})
+
+	t.Run("algorithm mismatch", func(t *testing.T) {
+		_, err := auth.authenticate(request(signTestJWT(t, "ES256", "rsa", ecKey, claims())))
+		require.Error(t, err)
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		_, err := auth.authenticate(httptest.NewRequest("GET", "/files", nil))
+		require.ErrorIs(t, err, errNoCredentials)
+	})
+}
+
+func TestAPIKeyAuthenticator(t *testing.T) {
+	auth, err := newAPIKeyAuthenticator([]identity{
+		{Key: "secret-key", UserID: "svc", Tenant: "acme", Roles: []string{"read"}},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/files", nil)
+	req.Header.Set("X-API-Key", "secret-key")
+	p, err := auth.authenticate(req)
+	require.NoError(t, err)
+	require.Equal(t, "acme", p.TenantID)
+
+	req = httptest.NewRequest("GET", "/files", nil)
+	req.Header.Set("Authorization", "Bearer secret-key")
+	_, err = auth.authenticate(req)
+	require.NoError(t, err)
+
+	req = httptest.NewRequest("GET", "/files", nil)
+	req.Header.Set("X-API-Key", "wrong")
+	_, err = auth.authenticate(req)
+	require.Error(t, err)
+
+	_, err = auth.authenticate(httptest.NewRequest("GET", "/files", nil))
+	require.ErrorIs(t, err, errNoCredentials)
+
+	_, err = newAPIKeyAuthenticator([]identity{{Key: "k", UserID: "svc", Tenant: "acme", Roles: []string{"admin"}}})
+	require.ErrorContains(t, err, "unknown role")
+
+	// every caller needs a user and tenant
+	_, err = newAPIKeyAuthenticator([]identity{{Key: "k", Tenant: "acme", Roles: []string{"read"}}})
+	require.ErrorContains(t, err, "missing a userID")
+	_, err = newAPIKeyAuthenticator([]identity{{Key: "k", UserID: "svc", Roles: []string{"read"}}})
+	require.ErrorContains(t, err, "missing a tenant")
+}
+
+func TestMTLSAuthenticator(t *testing.T) {
+	auth, err := newMTLSAuthenticator([]identity{
+		{Subject: "ledger.example.com", Tenant: "acme", Roles: []string{"read"}},
+	})
+	require.NoError(t, err)
+
+	request := func(cert *x509.Certificate) *http.Request {
+		req := httptest.NewRequest("GET", "/files", nil)
+		req.TLS = &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{cert}},
+		}
+		return req
+	}
+
+	p, err := auth.authenticate(request(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "ledger.example.com"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "ledger.example.com", p.UserID)
+
+	p, err = auth.authenticate(request(&x509.Certificate{
+		Subject:  pkix.Name{CommonName: "Ledger"},
+		DNSNames: []string{"ledger.example.com"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "acme", p.TenantID)
+
+	_, err = auth.authenticate(request(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "unknown"},
+	}))
+	require.Error(t, err)
+
+	_, err = auth.authenticate(httptest.NewRequest("GET", "/files", nil))
+	require.ErrorIs(t, err, errNoCredentials)
+
+	_, err = newMTLSAuthenticator([]identity{{Subject: "ledger.example.com", Roles: []string{"read"}}})
+	require.ErrorContains(t, err, "missing a tenant")
+}
+
+func TestAuth_tenantIsolation(t *testing.T) {
+	auth, err := newAPIKeyAuthenticator([]identity{
+		{Key: "acme-writer", UserID: "a", Tenant: "acme", Roles: []string{"read", "write"}},
+		{Key: "acme-reader", UserID: "b", Tenant: "acme", Roles: []string{"read"}},
+		{Key: "globex", UserID: "c", Tenant: "globex", Roles: []string{"read", "write"}},
+	})
+	require.NoError(t, err)
+
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	router.Use(authMiddleware(log.NewNopLogger(), authenticators{auth}))
+	addPingRoute(router)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	do := func(method, path, key string, body []byte) *httptest.ResponseRecorder {
+		var req *http.Request
+		if body != nil {
+			req = httptest.NewRequest(method, path, bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+		} else {
+			req = httptest.NewRequest(method, path, nil)
+		}
+		if key != "" {
+			req.Header.Set("X-API-Key", key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// ping is not authenticated
+	require.Equal(t, http.StatusOK, do("GET", "/ping", "", nil).Code)
+	require.Equal(t, http.StatusUnauthorized, do("GET", "/files", "", nil).Code)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(mockFEDWireMessage())
+	bs, err := json.Marshal(file)
+	require.NoError(t, err)
+
+	// readers can't write
+	require.Equal(t, http.StatusForbidden, do("POST", "/files/create", "acme-reader", bs).Code)
+
+	w := do("POST", "/files/create", "acme-writer", bs)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	var created wire.File
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+
+	require.Equal(t, http.StatusOK, do("GET", "/files/"+created.ID, "acme-reader", nil).Code)
+	require.Equal(t, http.StatusNotFound, do("GET", "/files/"+created.ID, "globex", nil).Code)
+
+	var files []*wire.File
+	w = do("GET", "/files", "globex", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&files))
+	require.Empty(t, files)
+
+	// deleting from another tenant leaves the file in place
+	require.Equal(t, http.StatusOK, do("DELETE", "/files/"+created.ID, "globex", nil).Code)
+	require.Equal(t, http.StatusOK, do("GET", "/files/"+created.ID, "acme-reader", nil).Code)
+}
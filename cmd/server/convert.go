@@ -0,0 +1,182 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+// readFileBody reads the Fedwire text, or JSON when the Content-Type is application/json, file in the
+// body of r and validates it with opts. Files which fail validation are returned along with the error,
+// while bodies which can't be decoded return a nil file.
+//
+// JSON is decoded with wire.FileFromJSONStrict when ?strict=true, in which case the keys and values it
+// rejects are returned like the errors of text which couldn't be parsed, along with an empty file.
+func readFileBody(r *http.Request, opts *wire.ValidateOpts) (*wire.File, []wire.Normalization, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), "application/json") {
+		reader := wire.NewReader(r.Body)
+		file, err := reader.ReadWithContext(r.Context(), opts)
+		return &file, reader.Normalizations(), err
+	}
+
+	file := wire.NewFile()
+	if strict, _ := strconv.ParseBool(r.URL.Query().Get("strict")); strict {
+		bs, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		file, err = wire.FileFromJSONStrict(bs)
+		if _, ok := err.(base.ErrorList); ok {
+			return wire.NewFile(), nil, err
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(file); err != nil {
+		return nil, nil, err
+	}
+	normalizations, err := checkFile(r.Context(), file, opts)
+	return file, normalizations, err
+}
+
+// checkFile validates file, which was decoded rather than read from text, with opts. It's normalized
+// first when opts asks for it, failing when a value no longer fits its field.
+func checkFile(ctx context.Context, file *wire.File, opts *wire.ValidateOpts) ([]wire.Normalization, error) {
+	var normalizations []wire.Normalization
+	if opts != nil && opts.NormalizeCharset {
+		normalizations = file.Normalize()
+		if err := wire.NormalizationOverflow(normalizations); err != nil {
+			return normalizations, err
+		}
+	}
+	file.SetValidation(opts)
+	return normalizations, file.ValidateWithContext(ctx)
+}
+
+// isParseError reports if err, returned by readFileBody, is about text which couldn't be parsed or a
+// value normalizing made too long rather than a file which failed validation
+func isParseError(err error) bool {
+	if errors.Is(err, wire.ErrNormalizedLength) {
+		return true
+	}
+	list, ok := err.(base.ErrorList)
+	return ok && !(len(list) == 1 && errors.Is(list[0], wire.ErrFileValidation))
+}
+
+// validationResponse is the result of validating a file. Invalid files are described by a validationProblem.
+type validationResponse struct {
+	Error    *string                `json:"error"`
+	Errors   []wire.ValidationIssue `json:"errors"`
+	Warnings []wire.ValidationIssue `json:"warnings"`
+}
+
+// validateBody validates the file in the request body without storing it
+func validateBody(logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		file, normalizations, err := readFileBody(r, validateOptsFromQuery(r.URL.Query()))
+		if file == nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		addNormalizationWarnings(w, normalizations)
+
+		if isParseError(err) {
+			issues := file.FEDWireMessage.IssuesFor(err)
+			recordFileInvalid(file, issues...)
+			annotateFileSpan(r, file, len(issues))
+			err = logRedactedError(logger, "error reading file", err)
+			writeValidationProblem(w, err, issues, nil)
+			return
+		}
+
+		result := file.ValidateDetailedWithContext(r.Context())
+		annotateFileSpan(r, file, len(result.Errors))
+		if err := result.Err(); err != nil {
+			recordFileInvalid(file, result.Errors...)
+			err = logRedactedError(logger, "file was invalid", err)
+			writeValidationProblem(w, err, result.Errors, result.Warnings)
+			return
+		}
+		recordFileValidated(file)
+
+		logger.Logf("validated file with %d warnings", len(result.Warnings))
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(validationResponse{
+			Errors:   result.Errors,
+			Warnings: result.Warnings,
+		})
+	}
+}
+
+// convertFile converts the file in the request body between Fedwire text and JSON without storing it.
+// Text is written with the format options of GetWriter, and ?format=json writes JSON.
+func convertFile(logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		file, normalizations, err := readFileBody(r, validateOptsFromQuery(r.URL.Query()))
+		if file == nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if err != nil {
+			issues := readIssues(r.Context(), file, err)
+			annotateFileSpan(r, file, len(issues))
+			err = logRedactedError(logger, "file was invalid", err)
+			writeValidationProblem(w, err, issues, nil)
+			return
+		}
+		annotateFileSpan(r, file, 0)
+		addNormalizationWarnings(w, normalizations)
+
+		if r.URL.Query().Get("format") == "json" {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(file)
+			return
+		}
+
+		var buf bytes.Buffer
+		writer, err := GetWriter(&buf, r)
+		if err != nil {
+			err = logger.LogErrorf("problem getting writer: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if err := writer.Write(file); err != nil {
+			err = logger.LogErrorf("problem converting file: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		w.Write(buf.Bytes())
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func statelessRouter(t *testing.T) (*mux.Router, *memoryWireFileRepository) {
+	t.Helper()
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+	return router, repo
+}
+
+func postBody(router *mux.Router, target, contentType string, body io.Reader) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", target, body)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	w.Flush()
+	return w
+}
+
+func TestValidateBody(t *testing.T) {
+	router, repo := statelessRouter(t)
+	text := readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+
+	w := postBody(router, "/validate", "text/plain", bytes.NewReader(text))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.JSONEq(t, `{"error":null,"errors":[],"warnings":[]}`, w.Body.String())
+
+	// nothing is stored
+	files, err := repo.getFiles("")
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	t.Run("json", func(t *testing.T) {
+		f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		require.NoError(t, err)
+		f.FEDWireMessage.Beneficiary.Personal.Name = strings.Repeat("A", 40)
+		bs, err := json.Marshal(f)
+		require.NoError(t, err)
+
+		w := postBody(router, "/validate", "application/json", bytes.NewReader(bs))
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+		var resp validationResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Len(t, resp.Warnings, 1)
+		require.Equal(t, wire.RuleTextTruncated, resp.Warnings[0].Rule)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		bs := bytes.Replace(text, []byte("{3600}CTR   *\n"), []byte("{3600}CTR   *\n{3610}ANSI*\n"), 1)
+
+		w := postBody(router, "/validate", "text/plain", bytes.NewReader(bs))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		require.Equal(t, "application/problem+json; charset=utf-8", w.Header().Get("Content-Type"))
+		var problem validationProblem
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&problem))
+		require.Len(t, problem.Errors, 1)
+		require.Equal(t, "W-3610-TAG-NOT-PERMITTED", problem.Errors[0].Code)
+	})
+
+	t.Run("parse errors", func(t *testing.T) {
+		bs := bytes.Replace(text, []byte("{2000}"), []byte("{2000}ABC"), 1)
+
+		w := postBody(router, "/validate", "text/plain", bytes.NewReader(bs))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		var problem validationProblem
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&problem))
+		require.Len(t, problem.Errors, 1)
+		require.Equal(t, "/fedWireMessage/amount", problem.Errors[0].Pointer)
+	})
+
+	t.Run("validate opts", func(t *testing.T) {
+		bs := bytes.Replace(text, []byte("{1520}20190410Source08000001\n"), nil, 1)
+
+		w := postBody(router, "/validate", "text/plain", bytes.NewReader(bs))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+		w = postBody(router, "/validate?skipMandatoryIMAD=true", "text/plain", bytes.NewReader(bs))
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+
+		f, err := wire.NewReader(bytes.NewReader(bs)).ReadWithOpts(&wire.ValidateOpts{SkipMandatoryIMAD: true})
+		require.NoError(t, err)
+		f.FEDWireMessage.ValidateOptions = nil
+		js, err := json.Marshal(f)
+		require.NoError(t, err)
+
+		w = postBody(router, "/validate", "application/json", bytes.NewReader(js))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+		w = postBody(router, "/validate?skipMandatoryIMAD=true", "application/json", bytes.NewReader(js))
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+	})
+
+	t.Run("normalized too long", func(t *testing.T) {
+		f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		require.NoError(t, err)
+		f.FEDWireMessage.Beneficiary.Personal.Name = strings.Repeat("A", 34) + "ß"
+		bs, err := json.Marshal(f)
+		require.NoError(t, err)
+
+		w := postBody(router, "/validate?normalizeCharset=true", "application/json", bytes.NewReader(bs))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		var problem validationProblem
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&problem))
+		require.Len(t, problem.Errors, 1)
+		require.Equal(t, wire.RuleLength, problem.Errors[0].Rule)
+		require.Equal(t, "/fedWireMessage/beneficiary/personal/name", problem.Errors[0].Pointer)
+	})
+
+	t.Run("malformed json", func(t *testing.T) {
+		w := postBody(router, "/validate", "application/json", strings.NewReader("{"))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		require.Contains(t, w.Body.String(), `"error"`)
+	})
+}
+
+func TestConvertFile(t *testing.T) {
+	router, repo := statelessRouter(t)
+	text := readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+
+	render := func(opts ...wire.OptionFunc) string {
+		var buf bytes.Buffer
+		require.NoError(t, wire.NewWriter(&buf, opts...).Write(f))
+		return buf.String()
+	}
+
+	t.Run("text to text", func(t *testing.T) {
+		w := postBody(router, "/convert?format=variable&newline=false", "text/plain", bytes.NewReader(text))
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+		require.Equal(t, "text/plain", w.Header().Get("Content-Type"))
+		require.Equal(t, render(wire.VariableLengthFields(true), wire.NewlineCharacter("")), w.Body.String())
+	})
+
+	t.Run("text to json", func(t *testing.T) {
+		w := postBody(router, "/convert?format=json", "text/plain", bytes.NewReader(text))
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+		var out wire.File
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&out))
+		require.Equal(t, f.FEDWireMessage.Beneficiary, out.FEDWireMessage.Beneficiary)
+	})
+
+	t.Run("json to text", func(t *testing.T) {
+		bs, err := json.Marshal(f)
+		require.NoError(t, err)
+		w := postBody(router, "/convert", "application/json", bytes.NewReader(bs))
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+		require.Equal(t, render(), w.Body.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		bs := bytes.Replace(text, []byte("{1520}20190410Source08000001\n"), nil, 1)
+		w := postBody(router, "/convert", "text/plain", bytes.NewReader(bs))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		require.Equal(t, "application/problem+json; charset=utf-8", w.Header().Get("Content-Type"))
+
+		w = postBody(router, "/convert?skipMandatoryIMAD=true", "text/plain", bytes.NewReader(bs))
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+	})
+
+	t.Run("bad newline", func(t *testing.T) {
+		w := postBody(router, "/convert?newline=maybe", "text/plain", bytes.NewReader(text))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	})
+
+	files, err := repo.getFiles("")
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
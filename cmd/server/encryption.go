@@ -0,0 +1,390 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+// encryptedPrefix starts each field value encrypted by encryptedWireFileRepository
+const encryptedPrefix = "enc:v1:"
+
+var (
+	errDecrypting      = errors.New("decrypting file")
+	errUnknownKey      = errors.New("unknown encryption key")
+	errEncryptedField  = errors.New("malformed encrypted field")
+	errKeyfilePrimary  = errors.New("encryption keyfile has no primary key")
+	errKeyfileKeyID    = errors.New("encryption key IDs can't be empty or contain ':'")
+	errKeyfileKeyBytes = errors.New("encryption keys must be 32 bytes")
+)
+
+// keyProvider wraps the data keys which encrypt stored files with key encryption keys. The primary key
+// wraps new data keys while earlier keys still unwrap the data keys of files written before a rotation.
+type keyProvider interface {
+	// primaryKeyID is the ID of the key which wraps new data keys
+	primaryKeyID() string
+	// wrapKey encrypts dataKey with the primary key
+	wrapKey(dataKey []byte) (keyID string, wrapped []byte, err error)
+	// unwrapKey decrypts a data key wrapped by the key keyID
+	unwrapKey(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// keyfile is the JSON format of a localKeyProvider file, for example:
+//
+//	{"primary": "2024-06", "keys": {"2024-06": "<base64 key>", "2023-11": "<base64 key>"}}
+//
+// Keys are 32 random bytes encoded in standard base64. Rotate by adding a key and making it primary,
+// the earlier keys are kept until every file has been re-encrypted.
+type keyfile struct {
+	Primary string            `json:"primary"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// localKeyProvider wraps data keys with AES-256-GCM keys read from a local keyfile
+type localKeyProvider struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+func readKeyfile(path string) (*localKeyProvider, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading encryption keyfile: %w", err)
+	}
+	var kf keyfile
+	if err := json.Unmarshal(bs, &kf); err != nil {
+		return nil, fmt.Errorf("reading encryption keyfile: %w", err)
+	}
+	return newLocalKeyProvider(kf)
+}
+
+func newLocalKeyProvider(kf keyfile) (*localKeyProvider, error) {
+	provider := &localKeyProvider{
+		primary: kf.Primary,
+		keys:    make(map[string]cipher.AEAD),
+	}
+	for id, encoded := range kf.Keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, errKeyfileKeyID
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %s: %w", id, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("encryption key %s: %w", id, errKeyfileKeyBytes)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		provider.keys[id] = aead
+	}
+	if provider.keys[provider.primary] == nil {
+		return nil, errKeyfilePrimary
+	}
+	return provider, nil
+}
+
+func (p *localKeyProvider) primaryKeyID() string {
+	return p.primary
+}
+
+func (p *localKeyProvider) wrapKey(dataKey []byte) (string, []byte, error) {
+	wrapped, err := seal(p.keys[p.primary], dataKey, []byte(p.primary))
+	return p.primary, wrapped, err
+}
+
+func (p *localKeyProvider) unwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnknownKey, keyID)
+	}
+	return open(aead, wrapped, []byte(keyID))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce, which is prepended to the ciphertext
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts ciphertext made by seal
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errEncryptedField
+	}
+	return aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], additionalData)
+}
+
+// encryptedWireFileRepository encrypts the account identifiers, names, addresses and other personal data
+// of the parties to each file (the fields wire.File.Redacted masks) before saving it to repo, and
+// decrypts them when files are read.
+//
+// Each save encrypts the fields with a new AES-256-GCM data key, wrapped by the primary key of keys and
+// stored alongside each field as enc:v1:<key ID>:<wrapped data key>:<ciphertext>. Fields are bound to
+// their tenant, file and path so they can't be moved between files. Reads never save, files encrypted
+// with a key which is no longer primary are saved again under the primary key by reencrypt.
+type encryptedWireFileRepository struct {
+	logger log.Logger
+	repo   WireFileRepository
+	keys   keyProvider
+
+	// mu orders saves and deletes with reencrypt so a file re-encrypted from an earlier read doesn't
+	// overwrite a newer save or bring back a deleted file
+	mu sync.Mutex
+}
+
+func newEncryptedWireFileRepository(logger log.Logger, repo WireFileRepository, keys keyProvider) *encryptedWireFileRepository {
+	return &encryptedWireFileRepository{logger: logger, repo: repo, keys: keys}
+}
+
+// getFiles returns the files of tenantID with their fields decrypted. Files which can't be decrypted
+// are logged and left out rather than failing the whole listing.
+func (r *encryptedWireFileRepository) getFiles(tenantID string) ([]*wire.File, error) {
+	stored, err := r.repo.getFiles(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*wire.File, 0, len(stored))
+	for i := range stored {
+		file, _, err := r.decrypt(tenantID, stored[i])
+		if err != nil {
+			r.logger.Set("tenantID", log.String(tenantID)).LogErrorf("skipping file which can't be decrypted: %v", err)
+			continue
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func (r *encryptedWireFileRepository) getFile(tenantID, fileId string) (*wire.File, error) {
+	file, err := r.repo.getFile(tenantID, fileId)
+	if err != nil || file == nil {
+		return file, err
+	}
+	file, _, err = r.decrypt(tenantID, file)
+	return file, err
+}
+
+func (r *encryptedWireFileRepository) saveFile(tenantID string, file *wire.File) error {
+	encrypted, err := r.encrypt(tenantID, file)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.repo.saveFile(tenantID, encrypted)
+}
+
+func (r *encryptedWireFileRepository) deleteFile(tenantID, fileId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.repo.deleteFile(tenantID, fileId)
+}
+
+// reencrypt saves the files of tenantID which have fields in plaintext or encrypted with a key which is
+// no longer primary again under the primary key. It returns how many files were saved and the IDs of
+// files which couldn't be decrypted, which are left as they are.
+func (r *encryptedWireFileRepository) reencrypt(tenantID string) (int, []string, error) {
+	stored, err := r.repo.getFiles(tenantID)
+	if err != nil {
+		return 0, nil, err
+	}
+	saved, failed := 0, []string{}
+	for i := range stored {
+		ok, err := r.reencryptFile(tenantID, stored[i].ID)
+		if errors.Is(err, errDecrypting) {
+			r.logger.Set("tenantID", log.String(tenantID)).LogErrorf("skipping file which can't be decrypted: %v", err)
+			failed = append(failed, stored[i].ID)
+			continue
+		}
+		if err != nil {
+			return saved, failed, err
+		}
+		if ok {
+			saved++
+		}
+	}
+	return saved, failed, nil
+}
+
+// reencryptFile saves the file fileID again under the primary key when it needs to be, reporting if it
+// was saved. The file is read and saved under mu so it can't change or be deleted in between.
+func (r *encryptedWireFileRepository) reencryptFile(tenantID, fileID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file, err := r.repo.getFile(tenantID, fileID)
+	if err != nil || file == nil {
+		return false, err
+	}
+	decrypted, stale, err := r.decrypt(tenantID, file)
+	if err != nil || !stale {
+		return false, err
+	}
+	encrypted, err := r.encrypt(tenantID, decrypted)
+	if err != nil {
+		return false, err
+	}
+	if err := r.repo.saveFile(tenantID, encrypted); err != nil {
+		return false, fmt.Errorf("re-encrypting file %s: %w", fileID, err)
+	}
+	return true, nil
+}
+
+// encrypt returns a copy of file with its sensitive fields encrypted under a new data key
+func (r *encryptedWireFileRepository) encrypt(tenantID string, file *wire.File) (*wire.File, error) {
+	dataKey := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	keyID, wrapped, err := r.keys.wrapKey(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	header := encryptedPrefix + keyID + ":" + base64.RawURLEncoding.EncodeToString(wrapped) + ":"
+
+	return file.MapSensitiveFields(func(path, value string) (string, error) {
+		ciphertext, err := seal(aead, []byte(value), fieldAdditionalData(tenantID, file.ID, path))
+		if err != nil {
+			return "", err
+		}
+		return header + base64.RawURLEncoding.EncodeToString(ciphertext), nil
+	})
+}
+
+// decrypt returns a copy of file with its encrypted fields decrypted, and reports if it's stale: has
+// fields in plaintext or encrypted with a key which is no longer primary. Errors wrap errDecrypting.
+func (r *encryptedWireFileRepository) decrypt(tenantID string, file *wire.File) (*wire.File, bool, error) {
+	dataKeys := make(map[string]cipher.AEAD)
+	stale := false
+
+	out, err := file.MapSensitiveFields(func(path, value string) (string, error) {
+		if !strings.HasPrefix(value, encryptedPrefix) {
+			// saved before encryption was enabled
+			stale = true
+			return value, nil
+		}
+		parts := strings.Split(strings.TrimPrefix(value, encryptedPrefix), ":")
+		if len(parts) != 3 {
+			return "", fmt.Errorf("%s: %w", path, errEncryptedField)
+		}
+		keyID, wrapped, ciphertext := parts[0], parts[1], parts[2]
+		if keyID != r.keys.primaryKeyID() {
+			stale = true
+		}
+
+		aead, ok := dataKeys[keyID+":"+wrapped]
+		if !ok {
+			bs, err := base64.RawURLEncoding.DecodeString(wrapped)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", path, errEncryptedField)
+			}
+			dataKey, err := r.keys.unwrapKey(keyID, bs)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", path, err)
+			}
+			if aead, err = newAEAD(dataKey); err != nil {
+				return "", err
+			}
+			dataKeys[keyID+":"+wrapped] = aead
+		}
+
+		bs, err := base64.RawURLEncoding.DecodeString(ciphertext)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", path, errEncryptedField)
+		}
+		plaintext, err := open(aead, bs, fieldAdditionalData(tenantID, file.ID, path))
+		if err != nil {
+			return "", fmt.Errorf("decrypting %s: %w", path, err)
+		}
+		return string(plaintext), nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("%w %s: %w", errDecrypting, file.ID, err)
+	}
+	return out, stale, nil
+}
+
+// fieldAdditionalData binds an encrypted field to its tenant, file and path
+func fieldAdditionalData(tenantID, fileID, path string) []byte {
+	return []byte(tenantID + "\x00" + fileID + "\x00" + path)
+}
+
+// newEncryptedWireFileRepositoryFromEnv wraps repo with encryption when WIRE_ENCRYPTION_KEYFILE is set,
+// otherwise repo is returned
+func newEncryptedWireFileRepositoryFromEnv(logger log.Logger, repo WireFileRepository) (WireFileRepository, error) {
+	path := os.Getenv("WIRE_ENCRYPTION_KEYFILE")
+	if path == "" {
+		return repo, nil
+	}
+	keys, err := readKeyfile(path)
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptedWireFileRepository(logger, repo, keys), nil
+}
+
+// reencryptFilesResponse is the result of re-encrypting the files of a tenant
+type reencryptFilesResponse struct {
+	Reencrypted int      `json:"reencrypted"`
+	Failed      []string `json:"failed"`
+}
+
+// reencryptFiles is the admin endpoint which re-encrypts the stored files of the tenant in ?tenantID=
+// under the primary key, for after a key rotation
+func reencryptFiles(logger log.Logger, repo *encryptedWireFileRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		tenantID := r.URL.Query().Get("tenantID")
+		logger := logger.Set("tenantID", log.String(tenantID))
+
+		saved, failed, err := repo.reencrypt(tenantID)
+		if err != nil {
+			err = logger.LogErrorf("problem re-encrypting files: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		logger.Logf("re-encrypted %d files, %d couldn't be decrypted", saved, len(failed))
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(reencryptFilesResponse{Reencrypted: saved, Failed: failed})
+	}
+}
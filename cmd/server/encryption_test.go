@@ -0,0 +1,225 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+)
+
+func testKeyfile(t *testing.T, primary string, ids ...string) keyfile {
+	t.Helper()
+	kf := keyfile{Primary: primary, Keys: make(map[string]string)}
+	for _, id := range ids {
+		kf.Keys[id] = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte(id[:1]), 32))
+	}
+	return kf
+}
+
+func testKeyProvider(t *testing.T, primary string, ids ...string) *localKeyProvider {
+	t.Helper()
+	keys, err := newLocalKeyProvider(testKeyfile(t, primary, ids...))
+	require.NoError(t, err)
+	return keys
+}
+
+func TestEncryptedWireFileRepository(t *testing.T) {
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = base.ID()
+
+	store := newMemoryWireFileRepository()
+	repo := newEncryptedWireFileRepository(log.NewNopLogger(), store, testKeyProvider(t, "a", "a"))
+	require.NoError(t, repo.saveFile("tenant", f))
+
+	// the caller's file isn't changed
+	require.Equal(t, "Name", f.FEDWireMessage.Beneficiary.Personal.Name)
+
+	// stored fields are encrypted
+	stored, err := store.getFile("tenant", f.ID)
+	require.NoError(t, err)
+	ben := stored.FEDWireMessage.Beneficiary.Personal
+	require.True(t, strings.HasPrefix(ben.Identifier, "enc:v1:a:"), ben.Identifier)
+	require.True(t, strings.HasPrefix(ben.Name, "enc:v1:a:"), ben.Name)
+	require.Equal(t, f.FEDWireMessage.Beneficiary.Personal.IdentificationCode, ben.IdentificationCode)
+	require.True(t, strings.HasPrefix(stored.FEDWireMessage.OriginatorToBeneficiary.LineOne, "enc:v1:a:"))
+	bs, err := json.Marshal(stored.FEDWireMessage.Beneficiary)
+	require.NoError(t, err)
+	require.NotContains(t, string(bs), "Address One")
+
+	// reads decrypt
+	got, err := repo.getFile("tenant", f.ID)
+	require.NoError(t, err)
+	require.Equal(t, f.FEDWireMessage, got.FEDWireMessage)
+
+	files, err := repo.getFiles("tenant")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, f.FEDWireMessage.Originator, files[0].FEDWireMessage.Originator)
+
+	// fields can't be read by another tenant or file
+	require.NoError(t, store.saveFile("other", stored))
+	_, err = repo.getFile("other", f.ID)
+	require.ErrorContains(t, err, "decrypting beneficiary.personal.")
+
+	got, err = repo.getFile("tenant", "missing")
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestEncryptedWireFileRepository_rotation(t *testing.T) {
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = base.ID()
+
+	store := newMemoryWireFileRepository()
+	require.NoError(t, newEncryptedWireFileRepository(log.NewNopLogger(), store, testKeyProvider(t, "a", "a")).saveFile("", f))
+
+	// "b" is the new primary key, "a" still decrypts
+	repo := newEncryptedWireFileRepository(log.NewNopLogger(), store, testKeyProvider(t, "b", "a", "b"))
+	got, err := repo.getFile("", f.ID)
+	require.NoError(t, err)
+	require.Equal(t, f.FEDWireMessage.Beneficiary, got.FEDWireMessage.Beneficiary)
+
+	// reads don't save
+	stored, err := store.getFile("", f.ID)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(stored.FEDWireMessage.Beneficiary.Personal.Name, "enc:v1:a:"))
+
+	// reencrypt saves the file again under "b"
+	saved, failed, err := repo.reencrypt("")
+	require.NoError(t, err)
+	require.Equal(t, 1, saved)
+	require.Empty(t, failed)
+	stored, err = store.getFile("", f.ID)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(stored.FEDWireMessage.Beneficiary.Personal.Name, "enc:v1:b:"))
+
+	// and only once
+	saved, _, err = repo.reencrypt("")
+	require.NoError(t, err)
+	require.Equal(t, 0, saved)
+
+	// so "a" can be removed
+	repo = newEncryptedWireFileRepository(log.NewNopLogger(), store, testKeyProvider(t, "b", "b"))
+	got, err = repo.getFile("", f.ID)
+	require.NoError(t, err)
+	require.Equal(t, f.FEDWireMessage.Beneficiary, got.FEDWireMessage.Beneficiary)
+
+	// files encrypted with an unknown key can't be read
+	repo = newEncryptedWireFileRepository(log.NewNopLogger(), store, testKeyProvider(t, "c", "c"))
+	_, err = repo.getFile("", f.ID)
+	require.ErrorIs(t, err, errUnknownKey)
+
+	// files saved before encryption was enabled are read as they are, and encrypted by reencrypt
+	plain, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	plain.ID = base.ID()
+	require.NoError(t, store.saveFile("", plain))
+	got, err = repo.getFile("", plain.ID)
+	require.NoError(t, err)
+	require.Equal(t, plain.FEDWireMessage.Beneficiary, got.FEDWireMessage.Beneficiary)
+
+	saved, failed, err = repo.reencrypt("")
+	require.NoError(t, err)
+	require.Equal(t, 1, saved)
+	require.Equal(t, []string{f.ID}, failed)
+	stored, err = store.getFile("", plain.ID)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(stored.FEDWireMessage.Beneficiary.Personal.Name, "enc:v1:c:"))
+
+	// listing skips the file which can't be decrypted
+	files, err := repo.getFiles("")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, plain.ID, files[0].ID)
+}
+
+func TestReencryptFiles(t *testing.T) {
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = base.ID()
+
+	store := newMemoryWireFileRepository()
+	require.NoError(t, newEncryptedWireFileRepository(log.NewNopLogger(), store, testKeyProvider(t, "a", "a")).saveFile("acme", f))
+
+	repo := newEncryptedWireFileRepository(log.NewNopLogger(), store, testKeyProvider(t, "b", "a", "b"))
+	handler := reencryptFiles(log.NewNopLogger(), repo)
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest("GET", "/files/reencrypt?tenantID=acme", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest("POST", "/files/reencrypt?tenantID=acme", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.JSONEq(t, `{"reencrypted":1,"failed":[]}`, w.Body.String())
+}
+
+func TestReadKeyfile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(kf keyfile) string {
+		bs, err := json.Marshal(kf)
+		require.NoError(t, err)
+		path := filepath.Join(dir, "keys.json")
+		require.NoError(t, os.WriteFile(path, bs, 0600))
+		return path
+	}
+
+	keys, err := readKeyfile(write(testKeyfile(t, "b", "a", "b")))
+	require.NoError(t, err)
+	require.Equal(t, "b", keys.primaryKeyID())
+
+	keyID, wrapped, err := keys.wrapKey([]byte("data key"))
+	require.NoError(t, err)
+	require.Equal(t, "b", keyID)
+	unwrapped, err := keys.unwrapKey(keyID, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, "data key", string(unwrapped))
+	_, err = keys.unwrapKey("a", wrapped)
+	require.Error(t, err)
+
+	_, err = readKeyfile(write(testKeyfile(t, "c", "a", "b")))
+	require.ErrorIs(t, err, errKeyfilePrimary)
+
+	_, err = readKeyfile(write(keyfile{Primary: "a", Keys: map[string]string{"a": base64.StdEncoding.EncodeToString([]byte("short"))}}))
+	require.ErrorIs(t, err, errKeyfileKeyBytes)
+
+	_, err = readKeyfile(write(testKeyfile(t, "a:1", "a:1")))
+	require.ErrorIs(t, err, errKeyfileKeyID)
+
+	_, err = readKeyfile(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
+
+func TestNewEncryptedWireFileRepositoryFromEnv(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+
+	t.Setenv("WIRE_ENCRYPTION_KEYFILE", "")
+	out, err := newEncryptedWireFileRepositoryFromEnv(log.NewNopLogger(), repo)
+	require.NoError(t, err)
+	require.Equal(t, repo, out)
+
+	bs, err := json.Marshal(testKeyfile(t, "a", "a"))
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "keys.json")
+	require.NoError(t, os.WriteFile(path, bs, 0600))
+
+	t.Setenv("WIRE_ENCRYPTION_KEYFILE", path)
+	out, err = newEncryptedWireFileRepositoryFromEnv(log.NewNopLogger(), repo)
+	require.NoError(t, err)
+	require.IsType(t, &encryptedWireFileRepository{}, out)
+}
@@ -0,0 +1,382 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+type eventType string
+
+const (
+	eventFileCreated   eventType = "file.created"
+	eventFileValidated eventType = "file.validated"
+	eventFileInvalid   eventType = "file.invalid"
+	eventFileDeleted   eventType = "file.deleted"
+)
+
+// workflowEventType returns the event published when a file moves to status,
+// for example "file.approved"
+func workflowEventType(status fileStatus) eventType {
+	return eventType("file." + string(status))
+}
+
+// fileEvent is the payload delivered to subscribers when a stored file changes
+type fileEvent struct {
+	ID                   string     `json:"id"`
+	Type                 eventType  `json:"type"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	TenantID             string     `json:"tenantID,omitempty"`
+	FileID               string     `json:"fileID"`
+	BusinessFunctionCode string     `json:"businessFunctionCode,omitempty"`
+	Amount               string     `json:"amount,omitempty"`
+	IMAD                 string     `json:"imad,omitempty"`
+	Status               fileStatus `json:"status,omitempty"`
+	Error                string     `json:"error,omitempty"`
+}
+
+// newFileEvent builds an event of typ describing file of tenantID. The file may be nil after it's deleted.
+func newFileEvent(typ eventType, tenantID, fileID string, file *wire.File) fileEvent {
+	event := fileEvent{
+		ID:        base.ID(),
+		Type:      typ,
+		CreatedAt: time.Now().UTC(),
+		TenantID:  tenantID,
+		FileID:    fileID,
+	}
+	if file == nil {
+		return event
+	}
+	fwm := file.FEDWireMessage
+	if fwm.BusinessFunctionCode != nil {
+		event.BusinessFunctionCode = fwm.BusinessFunctionCode.BusinessFunctionCode
+	}
+	if fwm.Amount != nil {
+		event.Amount = fwm.Amount.Amount
+	}
+	if imad := fwm.InputMessageAccountabilityData; imad != nil {
+		event.IMAD = imad.InputCycleDate + imad.InputSource + imad.InputSequenceNumber
+	}
+	return event
+}
+
+// eventPublisher delivers file events to downstream systems. Implementations should not block
+// the calling HTTP handler on slow subscribers.
+type eventPublisher interface {
+	publish(ctx context.Context, event fileEvent) error
+}
+
+// publishFileEvent sends event to events (if configured) and logs any delivery problem.
+func publishFileEvent(ctx context.Context, logger log.Logger, events eventPublisher, event fileEvent) {
+	if events == nil {
+		return
+	}
+	if err := events.publish(ctx, event); err != nil {
+		logger.LogErrorf("problem publishing %s event: %v", event.Type, err)
+	}
+}
+
+// multiPublisher fans each event out to several publishers
+type multiPublisher []eventPublisher
+
+func (m multiPublisher) publish(ctx context.Context, event fileEvent) error {
+	var el base.ErrorList
+	for i := range m {
+		if err := m[i].publish(ctx, event); err != nil {
+			el.Add(err)
+		}
+	}
+	if el.Empty() {
+		return nil
+	}
+	return el
+}
+
+// memoryPublisher keeps events in memory. It's used in tests and as a local stand-in for a message broker.
+type memoryPublisher struct {
+	mu     sync.Mutex
+	events []fileEvent
+}
+
+func (p *memoryPublisher) publish(_ context.Context, event fileEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *memoryPublisher) published() []fileEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]fileEvent(nil), p.events...)
+}
+
+var (
+	errWebhookQueueFull = errors.New("webhook queue is full")
+	errWebhookClosed    = errors.New("webhook publisher is closed")
+)
+
+const (
+	webhookSignatureHeader = "X-Wire-Signature"
+	webhookTimestampHeader = "X-Wire-Timestamp"
+	webhookEventHeader     = "X-Wire-Event"
+
+	// webhookShutdownTimeout bounds how long shutdown waits for queued events and their retries
+	webhookShutdownTimeout = 10 * time.Second
+)
+
+// webhookPublisher POSTs events as JSON to a URL. Each request is signed with an HMAC-SHA256
+// of the timestamp and body, and failed deliveries are retried with exponential backoff.
+// Retries wait outside the delivery queue, so an event being retried doesn't hold up the others.
+type webhookPublisher struct {
+	url    string
+	secret []byte
+	client *http.Client
+	logger log.Logger
+
+	maxRetries int
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	queue chan webhookDelivery
+	// ctx is cancelled when close stops waiting, which abandons in-flight requests and retries
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu     sync.Mutex
+	closed bool
+	// pending counts the deliveries which are queued, in-flight or waiting to be retried
+	pending sync.WaitGroup
+}
+
+// webhookDelivery is an event along with its delivery attempts so far
+type webhookDelivery struct {
+	event   fileEvent
+	body    []byte
+	attempt int
+	backoff time.Duration
+}
+
+func newWebhookPublisher(logger log.Logger, url string, secret []byte, maxRetries int) *webhookPublisher {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &webhookPublisher{
+		url:    url,
+		secret: secret,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		logger:     logger.Set("webhook", log.String(url)),
+		maxRetries: maxRetries,
+		backoff:    time.Second,
+		maxBackoff: time.Minute,
+		queue:      make(chan webhookDelivery, 1000),
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+func (p *webhookPublisher) publish(_ context.Context, event fileEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return errWebhookClosed
+	}
+	p.pending.Add(1)
+	select {
+	case p.queue <- webhookDelivery{event: event, body: body, backoff: p.backoff}:
+		return nil
+	default:
+		p.pending.Done()
+		return errWebhookQueueFull
+	}
+}
+
+// close stops accepting events and waits for queued deliveries, including their retries, to finish.
+// Deliveries still pending when ctx is done are abandoned.
+func (p *webhookPublisher) close(ctx context.Context) {
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+
+	delivered := make(chan struct{})
+	go func() {
+		p.pending.Wait()
+		close(delivered)
+	}()
+	select {
+	case <-delivered:
+	case <-ctx.Done():
+		p.logger.Logf("abandoning undelivered events: %v", ctx.Err())
+	}
+	p.cancel()
+	<-p.done
+}
+
+func (p *webhookPublisher) run() {
+	defer close(p.done)
+	for {
+		select {
+		case d := <-p.queue:
+			p.deliver(d)
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver makes one attempt to send d, scheduling a retry when it fails with a retryable error
+func (p *webhookPublisher) deliver(d webhookDelivery) {
+	retry, err := p.send(d.event, d.body)
+	if err == nil {
+		p.pending.Done()
+		return
+	}
+	if !retry || d.attempt >= p.maxRetries || p.ctx.Err() != nil {
+		p.logger.LogErrorf("giving up on %s event %s: %v", d.event.Type, d.event.ID, err)
+		p.pending.Done()
+		return
+	}
+
+	p.logger.Logf("retrying %s event %s in %v: %v", d.event.Type, d.event.ID, d.backoff, err)
+	next := d
+	next.attempt++
+	if next.backoff *= 2; next.backoff > p.maxBackoff {
+		next.backoff = p.maxBackoff
+	}
+	go func() {
+		timer := time.NewTimer(d.backoff)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-p.ctx.Done():
+			p.pending.Done()
+			return
+		}
+		// retries are queued even when the queue is full, waiting for room rather than being dropped
+		select {
+		case p.queue <- next:
+		case <-p.ctx.Done():
+			p.pending.Done()
+		}
+	}()
+}
+
+// send makes one delivery attempt and reports if a failure is worth retrying
+func (p *webhookPublisher) send(event fileEvent, body []byte) (bool, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(p.ctx, "POST", p.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set(webhookEventHeader, string(event.Type))
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookSignatureHeader, "sha256="+signWebhook(p.secret, timestamp, body))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+	}
+	return false, fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+}
+
+// signWebhook returns the hex encoded HMAC-SHA256 of "timestamp.body" which receivers
+// recompute to verify the X-Wire-Signature header.
+func signWebhook(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newEventPublisherFromEnv reads WIRE_WEBHOOK_URLS, WIRE_WEBHOOK_SECRET and WIRE_WEBHOOK_MAX_RETRIES.
+// A nil publisher is returned when no webhooks are configured.
+func newEventPublisherFromEnv(logger log.Logger) (eventPublisher, func(), error) {
+	var urls []string
+	for _, u := range strings.Split(os.Getenv("WIRE_WEBHOOK_URLS"), ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	if len(urls) == 0 {
+		return nil, func() {}, nil
+	}
+
+	secret := os.Getenv("WIRE_WEBHOOK_SECRET")
+	if secret == "" {
+		return nil, nil, errors.New("WIRE_WEBHOOK_SECRET is required for webhooks")
+	}
+
+	maxRetries := 5
+	if v := os.Getenv("WIRE_WEBHOOK_MAX_RETRIES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, nil, fmt.Errorf("invalid WIRE_WEBHOOK_MAX_RETRIES %q", v)
+		}
+		maxRetries = n
+	}
+
+	var publishers multiPublisher
+	var webhooks []*webhookPublisher
+	for _, u := range urls {
+		p := newWebhookPublisher(logger, u, []byte(secret), maxRetries)
+		publishers = append(publishers, p)
+		webhooks = append(webhooks, p)
+	}
+	shutdown := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), webhookShutdownTimeout)
+		defer cancel()
+
+		var wg sync.WaitGroup
+		for i := range webhooks {
+			wg.Add(1)
+			go func(p *webhookPublisher) {
+				defer wg.Done()
+				p.close(ctx)
+			}(webhooks[i])
+		}
+		wg.Wait()
+	}
+	return publishers, shutdown, nil
+}
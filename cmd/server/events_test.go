@@ -0,0 +1,225 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileEvent(t *testing.T) {
+	file := wire.NewFile()
+	file.AddFEDWireMessage(mockFEDWireMessage())
+
+	event := newFileEvent(eventFileCreated, "tenant", "foo", file)
+	require.NotEmpty(t, event.ID)
+	require.Equal(t, eventFileCreated, event.Type)
+	require.Equal(t, "tenant", event.TenantID)
+	require.Equal(t, "foo", event.FileID)
+	require.Equal(t, wire.CustomerTransfer, event.BusinessFunctionCode)
+	require.Equal(t, "000001234567", event.Amount)
+	require.Equal(t, file.FEDWireMessage.InputMessageAccountabilityData.InputCycleDate+"Source08000001", event.IMAD)
+
+	event = newFileEvent(eventFileDeleted, "", "foo", nil)
+	require.Equal(t, "foo", event.FileID)
+	require.Empty(t, event.Amount)
+
+	require.Equal(t, eventType("file.approved"), workflowEventType(statusApproved))
+}
+
+func TestFiles_publishEvents(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	workflow := &approvalWorkflow{
+		repo: newMemoryWorkflowRepository(),
+	}
+	events := &memoryPublisher{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, workflow, events)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(mockFEDWireMessage())
+	bs, err := json.Marshal(file)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-User-ID", "maker")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	var created *wire.File
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/"+created.ID+"/validate", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+
+	resp, _ := routerTransition(t, router, created.ID, "submit", "maker")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("DELETE", "/files/"+created.ID, nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+
+	published := events.published()
+	require.Len(t, published, 4)
+	require.Equal(t, eventFileCreated, published[0].Type)
+	require.Equal(t, eventFileValidated, published[1].Type)
+	require.Equal(t, eventType("file.submitted"), published[2].Type)
+	require.Equal(t, statusSubmitted, published[2].Status)
+	require.Equal(t, eventFileDeleted, published[3].Type)
+	for i := range published {
+		require.Equal(t, created.ID, published[i].FileID)
+		require.Equal(t, wire.CustomerTransfer, published[i].BusinessFunctionCode)
+	}
+}
+
+func TestFiles_publishInvalidEvent(t *testing.T) {
+	fwm := mockFEDWireMessage()
+	fwm.Amount = nil
+	repo := &testWireFileRepository{
+		file: &wire.File{ID: "foo", FEDWireMessage: fwm},
+	}
+	events := &memoryPublisher{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, events)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/foo/validate", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	published := events.published()
+	require.Len(t, published, 1)
+	require.Equal(t, eventFileInvalid, published[0].Type)
+	require.Contains(t, published[0].Error, "Amount")
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	secret := []byte("secret")
+	var attempts int32
+	received := make(chan fileEvent, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// fail the first delivery to exercise retries
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		expected := "sha256=" + signWebhook(secret, r.Header.Get(webhookTimestampHeader), body)
+		if r.Header.Get(webhookSignatureHeader) != expected {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var event fileEvent
+		json.Unmarshal(body, &event)
+		received <- event
+	}))
+	defer server.Close()
+
+	p := newWebhookPublisher(log.NewNopLogger(), server.URL, secret, 2)
+	p.backoff = time.Millisecond
+
+	require.NoError(t, p.publish(context.Background(), newFileEvent(eventFileCreated, "", "foo", nil)))
+
+	select {
+	case event := <-received:
+		require.Equal(t, eventFileCreated, event.Type)
+		require.Equal(t, "foo", event.FileID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for webhook")
+	}
+	p.close(context.Background())
+	require.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+
+	require.ErrorIs(t, p.publish(context.Background(), newFileEvent(eventFileCreated, "", "foo", nil)), errWebhookClosed)
+}
+
+func TestWebhookPublisher_noRetryOnClientError(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	p := newWebhookPublisher(log.NewNopLogger(), server.URL, []byte("secret"), 3)
+	p.backoff = time.Millisecond
+
+	require.NoError(t, p.publish(context.Background(), newFileEvent(eventFileDeleted, "", "foo", nil)))
+	p.close(context.Background())
+	require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestWebhookPublisher_retriesDontBlock(t *testing.T) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event fileEvent
+		json.NewDecoder(r.Body).Decode(&event)
+		if event.FileID == "failing" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received <- event.FileID
+	}))
+	defer server.Close()
+
+	p := newWebhookPublisher(log.NewNopLogger(), server.URL, []byte("secret"), 5)
+	p.backoff = time.Hour
+
+	require.NoError(t, p.publish(context.Background(), newFileEvent(eventFileCreated, "", "failing", nil)))
+	require.NoError(t, p.publish(context.Background(), newFileEvent(eventFileCreated, "", "foo", nil)))
+
+	// the second event is delivered while the first waits to be retried
+	select {
+	case fileID := <-received:
+		require.Equal(t, "foo", fileID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for webhook")
+	}
+
+	// shutdown gives up on the retry rather than waiting for it
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	p.close(ctx)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestNewEventPublisherFromEnv(t *testing.T) {
+	t.Setenv("WIRE_WEBHOOK_URLS", "")
+	events, closeEvents, err := newEventPublisherFromEnv(log.NewNopLogger())
+	require.NoError(t, err)
+	require.Nil(t, events)
+	closeEvents()
+
+	t.Setenv("WIRE_WEBHOOK_URLS", "http://localhost:9999/hook")
+	_, _, err = newEventPublisherFromEnv(log.NewNopLogger())
+	require.ErrorContains(t, err, "WIRE_WEBHOOK_SECRET")
+
+	t.Setenv("WIRE_WEBHOOK_SECRET", "secret")
+	t.Setenv("WIRE_WEBHOOK_MAX_RETRIES", "-1")
+	_, _, err = newEventPublisherFromEnv(log.NewNopLogger())
+	require.ErrorContains(t, err, "WIRE_WEBHOOK_MAX_RETRIES")
+
+	t.Setenv("WIRE_WEBHOOK_MAX_RETRIES", "1")
+	events, closeEvents, err = newEventPublisherFromEnv(log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	closeEvents()
+}
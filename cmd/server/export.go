@@ -0,0 +1,141 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+const (
+	exportFedwire = "fedwire"
+	exportJSONL   = "jsonl"
+	exportZip     = "zip"
+)
+
+// exportFiles writes every file matching the listing filters as one response. The `output` query param
+// selects concatenated Fedwire text (the default), JSON Lines or a zip with one Fedwire file per entry.
+// Fedwire output honors the same `format` and `newline` query params as GET /files/{fileId}/contents.
+func exportFiles(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		output := r.URL.Query().Get("output")
+		if output == "" {
+			output = exportFedwire
+		}
+		if output != exportFedwire && output != exportJSONL && output != exportZip {
+			moovhttp.Problem(w, logger.LogErrorf("unknown export output %q", output).Err())
+			return
+		}
+
+		files, err := getFilteredFiles(r, repo, workflow)
+		if err != nil {
+			err = logger.LogErrorf("error listing files: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		// Problems are reported before anything is written, as the response can't be changed once streaming starts.
+		if output != exportJSONL {
+			for _, file := range files {
+				if err := workflow.canRender(getTenantID(r), file.ID); err != nil {
+					logger.LogErrorf("refusing to export file %s: %v", file.ID, err)
+					workflowProblem(w, fmt.Errorf("file %s: %w", file.ID, err))
+					return
+				}
+				if err := file.ValidateWithContext(r.Context()); err != nil {
+					err = logRedactedError(logger, fmt.Sprintf("file %s is invalid", file.ID), err)
+					moovhttp.Problem(w, err)
+					return
+				}
+			}
+			// check the format options up front as well
+			if _, err := GetWriter(w, r); err != nil {
+				err = logger.LogErrorf("problem getting writer: %v", err).Err()
+				moovhttp.Problem(w, err)
+				return
+			}
+		}
+		logger.Logf("exporting %d files as %s", len(files), output)
+
+		w.Header().Set("X-Total-Count", fmt.Sprintf("%d", len(files)))
+		switch output {
+		case exportFedwire:
+			err = exportFedwireText(w, r, files)
+		case exportJSONL:
+			err = exportJSONLines(w, files)
+		case exportZip:
+			err = exportZipArchive(w, r, files)
+		}
+		if err != nil {
+			// headers were already sent, so all we can do is log
+			logger.LogErrorf("problem exporting files: %v", err)
+		}
+	}
+}
+
+func exportFedwireText(w http.ResponseWriter, r *http.Request, files []*wire.File) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.Header().Set("Content-Disposition", `attachment; filename="wire-export.txt"`)
+	w.WriteHeader(http.StatusOK)
+
+	writer, err := GetWriter(w, r)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := writer.Write(file); err != nil {
+			return fmt.Errorf("file %s: %v", file.ID, err)
+		}
+	}
+	return nil
+}
+
+func exportJSONLines(w http.ResponseWriter, files []*wire.File) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", `attachment; filename="wire-export.jsonl"`)
+	w.WriteHeader(http.StatusOK)
+
+	enc := json.NewEncoder(w)
+	for _, file := range files {
+		if err := enc.Encode(file); err != nil {
+			return fmt.Errorf("file %s: %v", file.ID, err)
+		}
+	}
+	return nil
+}
+
+func exportZipArchive(w http.ResponseWriter, r *http.Request, files []*wire.File) error {
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="wire-export.zip"`)
+	w.WriteHeader(http.StatusOK)
+
+	zw := zip.NewWriter(w)
+	for _, file := range files {
+		entry, err := zw.Create(file.ID + ".txt")
+		if err != nil {
+			return err
+		}
+		writer, err := GetWriter(entry, r)
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(file); err != nil {
+			return fmt.Errorf("file %s: %v", file.ID, err)
+		}
+	}
+	return zw.Close()
+}
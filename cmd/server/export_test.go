@@ -0,0 +1,165 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// saveExportFiles stores two files on consecutive days, returned in IMAD order
+func saveExportFiles(t *testing.T, repo WireFileRepository) []*wire.File {
+	t.Helper()
+
+	var out []*wire.File
+	for i, cycleDate := range []string{"20240212", "20240213"} {
+		fwm := mockFEDWireMessage()
+		fwm.InputMessageAccountabilityData.InputCycleDate = cycleDate
+
+		file := wire.NewFile()
+		file.ID = []string{"b", "a"}[i]
+		file.AddFEDWireMessage(fwm)
+		require.NoError(t, repo.saveFile("", file))
+		out = append(out, file)
+	}
+	return out
+}
+
+func routerExport(t *testing.T, router *mux.Router, query string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/export?"+query, nil))
+	w.Flush()
+	return w
+}
+
+func TestFiles_exportFedwire(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	files := saveExportFiles(t, repo)
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	w := routerExport(t, router, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "text/plain", w.Header().Get("Content-Type"))
+	require.Equal(t, "2", w.Header().Get("X-Total-Count"))
+
+	// the export can be imported again
+	messages := readImportText(context.Background(), "", w.Body.Bytes(), nil)
+	require.Len(t, messages, 2)
+	for i := range messages {
+		require.NoError(t, messages[i].err)
+		require.Equal(t, files[i].FEDWireMessage.InputMessageAccountabilityData, messages[i].file.FEDWireMessage.InputMessageAccountabilityData)
+	}
+
+	w = routerExport(t, router, "newline=false&format=variable")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.NotContains(t, w.Body.String(), "\n")
+	require.Equal(t, 2, strings.Count(w.Body.String(), wire.TagSenderSupplied))
+
+	w = routerExport(t, router, "inputCycleDate=20240213")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, 1, strings.Count(w.Body.String(), wire.TagSenderSupplied))
+
+	w = routerExport(t, router, "output=csv")
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	w = routerExport(t, router, "newline=maybe")
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+}
+
+func TestFiles_exportJSONL(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	files := saveExportFiles(t, repo)
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	w := routerExport(t, router, "output=jsonl&businessFunctionCode=CTR")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+	var ids []string
+	scanner := bufio.NewScanner(w.Body)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var file wire.File
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &file))
+		ids = append(ids, file.ID)
+	}
+	require.Equal(t, []string{files[0].ID, files[1].ID}, ids)
+
+	w = routerExport(t, router, "output=jsonl&businessFunctionCode=BTR")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Empty(t, w.Body.String())
+}
+
+func TestFiles_exportZip(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	files := saveExportFiles(t, repo)
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	w := routerExport(t, router, "output=zip")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 2)
+	for i, f := range zr.File {
+		require.Equal(t, files[i].ID+".txt", f.Name)
+
+		rc, err := f.Open()
+		require.NoError(t, err)
+		_, err = wire.NewReader(rc).Read()
+		require.NoError(t, err)
+		rc.Close()
+	}
+}
+
+func TestFiles_exportWorkflow(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	workflow := &approvalWorkflow{
+		repo: newMemoryWorkflowRepository(),
+	}
+	files := saveExportFiles(t, repo)
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, workflow, nil)
+
+	require.NoError(t, workflow.repo.saveWorkflow("", &fileWorkflow{
+		FileID: files[1].ID,
+		Status: statusApproved,
+	}))
+
+	// drafts can't be exported for transmission
+	w := routerExport(t, router, "")
+	require.Equal(t, http.StatusForbidden, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), files[0].ID)
+
+	w = routerExport(t, router, "status=approved")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "1", w.Header().Get("X-Total-Count"))
+
+	w = routerExport(t, router, "status=unknown")
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	// JSON isn't rendered for transmission
+	w = routerExport(t, router, "output=jsonl")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "2", w.Header().Get("X-Total-Count"))
+}
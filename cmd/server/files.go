@@ -11,15 +11,15 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
-	"strings"
 
 	"github.com/go-kit/kit/metrics/prometheus"
 	"github.com/gorilla/mux"
-	"github.com/moov-io/base"
 	moovhttp "github.com/moov-io/base/http"
 	"github.com/moov-io/base/log"
 	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/wirepb"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 )
 
@@ -27,25 +27,61 @@ var (
 	filesCreated = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 		Name: "wire_files_created",
 		Help: "The number of WIRE files created",
-	}, nil) // TODO(adam): add key/value pairs []string{"destination", "origin"}
+	}, []string{"business_function_code", "type_subtype"})
 
 	filesDeleted = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 		Name: "wire_files_deleted",
 		Help: "The number of WIRE files deleted",
 	}, nil)
 
+	filesValidated = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Name: "wire_files_validated",
+		Help: "The number of WIRE files which passed validation",
+	}, []string{"business_function_code", "type_subtype"})
+
+	filesInvalid = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Name: "wire_files_invalid",
+		Help: "The number of WIRE files which failed validation when created, imported or validated",
+	}, []string{"business_function_code", "type_subtype"})
+
+	validationFailures = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Name: "wire_validation_failures",
+		Help: "The number of validation errors by rule code",
+	}, []string{"rule_code"})
+
+	fileAmounts = prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+		Name:    "wire_file_amount_dollars",
+		Help:    "Histogram of the amounts of WIRE files created, in dollars",
+		Buckets: stdprometheus.ExponentialBuckets(10, 10, 9), // $10 to $1bn
+	}, []string{"business_function_code"})
+
 	errNoFileId           = errors.New("no File ID found")
+	errFileExists         = errors.New("a File with this ID already exists")
 	errNoFEDWireMessageID = errors.New("no FEDWireMessage ID found")
+	errStatusFilter       = errors.New("status filter requires the approval workflow")
 )
 
-func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository) {
-	r.Methods("GET").Path("/files").HandlerFunc(getFiles(logger, repo))
-	r.Methods("POST").Path("/files/create").HandlerFunc(createFile(logger, repo))
-	r.Methods("GET").Path("/files/{fileId}").HandlerFunc(getFile(logger, repo))
-	r.Methods("DELETE").Path("/files/{fileId}").HandlerFunc(deleteFile(logger, repo))
-	r.Methods("GET").Path("/files/{fileId}/contents").HandlerFunc(getFileContents(logger, repo))
-	r.Methods("GET").Path("/files/{fileId}/validate").HandlerFunc(validateFile(logger, repo))
-	r.Methods("POST").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(addFEDWireMessageToFile(logger, repo))
+// addFileRoutes registers the file handlers on r. When workflow is non-nil stored files
+// follow the maker-checker approval lifecycle, and when events is non-nil changes to
+// stored files are published to it.
+func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher) {
+	r.Methods("GET").Path("/files").HandlerFunc(requireRole(roleRead, getFiles(logger, repo, workflow)))
+	r.Methods("GET").Path("/files/export").HandlerFunc(requireRole(roleRead, exportFiles(logger, repo, workflow)))
+	r.Methods("POST").Path("/files/create").HandlerFunc(requireRole(roleWrite, createFile(logger, repo, workflow, events)))
+	r.Methods("POST").Path("/files/import").HandlerFunc(requireRole(roleWrite, importFiles(logger, repo, workflow, events)))
+	r.Methods("POST").Path("/files/import/csv").HandlerFunc(requireRole(roleWrite, importCSV(logger, repo, workflow, events)))
+	r.Methods("GET").Path("/files/schema").HandlerFunc(requireRole(roleRead, getFileSchema(logger)))
+	r.Methods("GET").Path("/files/{fileId}").HandlerFunc(requireRole(roleRead, getFile(logger, repo)))
+	r.Methods("DELETE").Path("/files/{fileId}").HandlerFunc(requireRole(roleWrite, deleteFile(logger, repo, workflow, events)))
+	r.Methods("GET").Path("/files/{fileId}/contents").HandlerFunc(requireRole(roleRead, getFileContents(logger, repo, workflow)))
+	r.Methods("GET").Path("/files/{fileId}/validate").HandlerFunc(requireRole(roleRead, validateFile(logger, repo, events)))
+	r.Methods("POST").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(requireRole(roleWrite, addFEDWireMessageToFile(logger, repo, workflow)))
+	r.Methods("POST").Path("/validate").HandlerFunc(requireRole(roleRead, validateBody(logger)))
+	r.Methods("POST").Path("/convert").HandlerFunc(requireRole(roleRead, convertFile(logger)))
+
+	if workflow != nil {
+		addWorkflowRoutes(logger, r, repo, workflow, events)
+	}
 }
 
 func getFileId(w http.ResponseWriter, r *http.Request) string {
@@ -66,7 +102,96 @@ func getFEDWireMessageID(w http.ResponseWriter, r *http.Request) string {
 	return v
 }
 
-func getFiles(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+// fileFilter holds the query params used to narrow down GET /files and GET /files/export
+type fileFilter struct {
+	businessFunctionCode string
+	typeCode             string
+	subTypeCode          string
+	inputCycleDate       string
+	status               fileStatus
+}
+
+func fileFilterFromQuery(query url.Values, workflow *approvalWorkflow) (fileFilter, error) {
+	filter := fileFilter{
+		businessFunctionCode: query.Get("businessFunctionCode"),
+		typeCode:             query.Get("typeCode"),
+		subTypeCode:          query.Get("subTypeCode"),
+		inputCycleDate:       query.Get("inputCycleDate"),
+		status:               fileStatus(query.Get("status")),
+	}
+	if filter.status != "" {
+		if workflow == nil {
+			return filter, errStatusFilter
+		}
+		switch filter.status {
+		case statusDraft, statusSubmitted, statusApproved, statusRejected, statusReleased:
+		default:
+			return filter, fmt.Errorf("unknown status %q", filter.status)
+		}
+	}
+	return filter, nil
+}
+
+// filterFiles returns the files matching filter, ordered by IMAD and then ID so listings are stable
+func filterFiles(tenantID string, files []*wire.File, filter fileFilter, workflow *approvalWorkflow) ([]*wire.File, error) {
+	out := make([]*wire.File, 0, len(files))
+	for _, file := range files {
+		fwm := file.FEDWireMessage
+		if filter.businessFunctionCode != "" &&
+			(fwm.BusinessFunctionCode == nil || fwm.BusinessFunctionCode.BusinessFunctionCode != filter.businessFunctionCode) {
+			continue
+		}
+		if filter.typeCode != "" && (fwm.TypeSubType == nil || fwm.TypeSubType.TypeCode != filter.typeCode) {
+			continue
+		}
+		if filter.subTypeCode != "" && (fwm.TypeSubType == nil || fwm.TypeSubType.SubTypeCode != filter.subTypeCode) {
+			continue
+		}
+		if filter.inputCycleDate != "" &&
+			(fwm.InputMessageAccountabilityData == nil || fwm.InputMessageAccountabilityData.InputCycleDate != filter.inputCycleDate) {
+			continue
+		}
+		if filter.status != "" {
+			state, err := workflow.lookup(tenantID, file.ID)
+			if err != nil {
+				return nil, err
+			}
+			if state.Status != filter.status {
+				continue
+			}
+		}
+		out = append(out, file)
+	}
+
+	imad := func(f *wire.File) string {
+		if d := f.FEDWireMessage.InputMessageAccountabilityData; d != nil {
+			return d.InputCycleDate + d.InputSource + d.InputSequenceNumber
+		}
+		return ""
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if a, b := imad(out[i]), imad(out[j]); a != b {
+			return a < b
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+// getFilteredFiles reads the files of the caller's tenant which match the request's query params
+func getFilteredFiles(r *http.Request, repo WireFileRepository, workflow *approvalWorkflow) ([]*wire.File, error) {
+	filter, err := fileFilterFromQuery(r.URL.Query(), workflow)
+	if err != nil {
+		return nil, err
+	}
+	files, err := repo.getFiles(getTenantID(r)) // TODO(adam): implement soft and hard limits
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving files: %v", err)
+	}
+	return filterFiles(getTenantID(r), files, filter, workflow)
+}
+
+func getFiles(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
@@ -74,9 +199,9 @@ func getFiles(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 
 		w = wrapResponseWriter(logger, w, r)
 
-		files, err := repo.getFiles() // TODO(adam): implement soft and hard limits
+		files, err := getFilteredFiles(r, repo, workflow)
 		if err != nil {
-			err = logger.LogErrorf("error retrieving files: %v", err).Err()
+			err = logger.LogErrorf("error listing files: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
 		}
@@ -89,7 +214,7 @@ func getFiles(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
-func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func createFile(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
@@ -97,50 +222,53 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 
 		w = wrapResponseWriter(logger, w, r)
 
-		file := wire.NewFile()
-		if strings.Contains(r.Header.Get("Content-Type"), "application/json") {
-			if err := json.NewDecoder(r.Body).Decode(file); err != nil {
-				err = logger.LogErrorf("error reading request body: %v", err).Err()
-				moovhttp.Problem(w, err)
-				return
-			}
-
-			if err := file.Validate(); err != nil {
-				err = logger.LogErrorf("file validation failed: %v", err).Err()
-				moovhttp.Problem(w, err)
-				return
-			}
-		} else {
-			f, err := wire.NewReader(r.Body).ReadWithOpts(validateOptsFromQuery(r.URL.Query()))
-			if err != nil {
-				err = logger.LogErrorf("error reading file: %v", err).Err()
-				moovhttp.Problem(w, err)
-				return
-			}
-			file = &f
+		file, normalizations, err := readFileBody(r, validateOptsFromQuery(r.URL.Query()))
+		if file == nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
 		}
-
-		if file.ID == "" {
-			file.ID = base.ID()
+		if err != nil {
+			// list every error rather than the first
+			issues := readIssues(r.Context(), file, err)
+			recordFileInvalid(file, issues...)
+			annotateFileSpan(r, file, len(issues))
+			err = logRedactedError(logger, "error reading file", err)
+			writeValidationProblem(w, err, issues, nil)
+			return
 		}
-		logger = logger.Set("fileID", log.String(file.ID))
 
-		if err := repo.saveFile(file); err != nil {
-			err = logger.LogErrorf("problem saving file: %v", err).Err()
-			moovhttp.Problem(w, err)
+		annotateFileSpan(r, file, 0)
+		if err := storeCreatedFile(r, logger, repo, workflow, events, file); err != nil {
+			workflowProblem(w, err)
 			return
 		}
-		logger.Log("created file")
-
-		// record a metric for files created
-		filesCreated.Add(1) // TODO(adam): add key/value pairs (like in ACH)
 
+		if len(normalizations) > 0 {
+			logger.Logf("normalized %d fields", len(normalizations))
+			addNormalizationWarnings(w, normalizations)
+		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusCreated)
 		json.NewEncoder(w).Encode(file)
 	}
 }
 
+// getFileSchema returns the JSON Schema of the files created and returned as JSON
+func getFileSchema(logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		w.Header().Set("Content-Type", "application/schema+json")
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, wirepb.JSONSchema)
+	}
+}
+
 func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
@@ -156,7 +284,7 @@ func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		file, err := repo.getFile(fileId)
+		file, err := repo.getFile(getTenantID(r), fileId)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -169,6 +297,10 @@ func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			return
 		}
 
+		if redact, _ := strconv.ParseBool(r.URL.Query().Get("redact")); redact {
+			file = file.Redacted(wire.DefaultRedactionPolicy)
+		}
+
 		logger.Log("rendering file")
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
@@ -176,7 +308,7 @@ func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
-func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func deleteFile(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
@@ -191,15 +323,31 @@ func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		if err := repo.deleteFile(fileId); err != nil {
+		unlock := workflow.lock(getTenantID(r), fileId)
+		defer unlock()
+
+		// read the file first so the deleted event can describe it
+		var deleted *wire.File
+		if events != nil {
+			deleted, _ = repo.getFile(getTenantID(r), fileId)
+		}
+
+		if err := repo.deleteFile(getTenantID(r), fileId); err != nil {
 			err = logger.LogErrorf("error deleting file: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
 		}
+		if err := workflow.deleted(getTenantID(r), fileId); err != nil {
+			err = logger.LogErrorf("error deleting file workflow: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
 		logger.Log("deleted file")
 
 		filesDeleted.Add(1)
 
+		publishFileEvent(r.Context(), logger, events, newFileEvent(eventFileDeleted, getTenantID(r), fileId, deleted))
+
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
 
@@ -211,7 +359,7 @@ func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
-func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func getFileContents(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
@@ -226,7 +374,7 @@ func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFun
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		file, err := repo.getFile(fileId)
+		file, err := repo.getFile(getTenantID(r), fileId)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -237,6 +385,11 @@ func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFun
 			http.NotFound(w, r)
 			return
 		}
+		if err := workflow.canRender(getTenantID(r), fileId); err != nil {
+			logger.LogErrorf("refusing to render file contents: %v", err)
+			workflowProblem(w, err)
+			return
+		}
 		logger.Log("rendering file contents")
 
 		writer, err := GetWriter(w, r)
@@ -256,7 +409,7 @@ func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFun
 	}
 }
 
-func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func validateFile(logger log.Logger, repo WireFileRepository, events eventPublisher) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
@@ -271,7 +424,7 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		file, err := repo.getFile(fileId)
+		file, err := repo.getFile(getTenantID(r), fileId)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -284,25 +437,59 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			return
 		}
 
-		if err := file.Create(); err != nil { // Create calls Validate
-			err = logger.LogErrorf("file was invalid: %v", err).Err()
+		// other files are checked for a reused SenderReference
+		sent, err := repo.getFiles(getTenantID(r))
+		if err != nil {
+			err = logger.LogErrorf("error retrieving files: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
 		}
+		result, err := validateStoredFile(r, logger, events, fileId, file, sent)
+		if err != nil {
+			moovhttp.Problem(w, err)
+			return
+		}
+		if err := result.Err(); err != nil {
+			writeValidationProblem(w, fmt.Errorf("file was invalid: %v", err), result.Errors, result.Warnings)
+			return
+		}
 
-		logger.Log("validated file")
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&validationResponse{
+			Errors:   result.Errors,
+			Warnings: result.Warnings,
+		})
+	}
+}
 
-		type response struct {
-			Error error `json:"error"`
-		}
+// validateStoredFile validates a stored file against sent, the other files of its tenant, and records
+// the outcome in metrics and an event. An error is only returned when the file can't be validated.
+func validateStoredFile(r *http.Request, logger log.Logger, events eventPublisher, fileID string, file *wire.File, sent []*wire.File) (*wire.ValidationResult, error) {
+	if err := file.Create(); err != nil {
+		return nil, logger.LogErrorf("problem creating file: %v", err).Err()
+	}
 
-		json.NewEncoder(w).Encode(&response{Error: nil})
+	result := file.ValidateDetailedWithContext(r.Context(), sent...)
+	annotateFileSpan(r, file, len(result.Errors))
+
+	if err := result.Err(); err != nil {
+		recordFileInvalid(file, result.Errors...)
+
+		event := newFileEvent(eventFileInvalid, getTenantID(r), fileID, file)
+		event.Error = wire.RedactError(err, wire.DefaultRedactionPolicy).Error()
+		logRedactedError(logger, "file was invalid", err)
+		publishFileEvent(r.Context(), logger, events, event)
+		return result, nil
 	}
+	recordFileValidated(file)
+	publishFileEvent(r.Context(), logger, events, newFileEvent(eventFileValidated, getTenantID(r), fileID, file))
+
+	logger.Logf("validated file with %d warnings", len(result.Warnings))
+	return result, nil
 }
 
-func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
@@ -324,7 +511,11 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		file, err := repo.getFile(fileId)
+		// the file isn't submitted while it's edited
+		unlock := workflow.lock(getTenantID(r), fileId)
+		defer unlock()
+
+		file, err := repo.getFile(getTenantID(r), fileId)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -337,8 +528,14 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 			return
 		}
 
+		if err := workflow.modified(getTenantID(r), fileId); err != nil {
+			logger.LogErrorf("refusing to modify file: %v", err)
+			workflowProblem(w, err)
+			return
+		}
+
 		file.FEDWireMessage = file.AddFEDWireMessage(req)
-		if err := repo.saveFile(file); err != nil {
+		if err := repo.saveFile(getTenantID(r), file); err != nil {
 			err = logger.LogErrorf("error saving file: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
@@ -392,6 +589,20 @@ func GetWriter(w io.Writer, r *http.Request) (*wire.Writer, error) {
 	return writer, nil
 }
 
+// addNormalizationWarnings adds a Warning header for each field changed by ValidateOpts.NormalizeCharset
+func addNormalizationWarnings(w http.ResponseWriter, normalizations []wire.Normalization) {
+	for _, n := range normalizations {
+		w.Header().Add("Warning", fmt.Sprintf("299 wire %+q", fmt.Sprintf("%s %s normalized from %q to %q", n.Tag, n.Field, n.Original, n.Normalized)))
+	}
+}
+
+// logRedactedError logs err after msg with the values of sensitive fields masked. The unmasked error is
+// returned for the client, which sent the values.
+func logRedactedError(logger log.Logger, msg string, err error) error {
+	logger.LogErrorf("%s: %v", msg, wire.RedactError(err, wire.DefaultRedactionPolicy))
+	return fmt.Errorf("%s: %v", msg, err)
+}
+
 // validateOptsFromQuery returns a ValidateOpts struct based on the query params.
 // If no validation query params were provided, opts will be nil.
 func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
@@ -400,13 +611,19 @@ func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
 	}
 
 	const (
-		skipMandatoryIMAD          = "skipMandatoryIMAD"
-		allowMissingSenderSupplied = "allowMissingSenderSupplied"
+		skipMandatoryIMAD            = "skipMandatoryIMAD"
+		allowMissingSenderSupplied   = "allowMissingSenderSupplied"
+		skipRemittanceReconciliation = "skipRemittanceReconciliation"
+		repairAddendaLength          = "repairAddendaLength"
+		normalizeCharset             = "normalizeCharset"
 	)
 
 	validationNames := []string{
 		skipMandatoryIMAD,
 		allowMissingSenderSupplied,
+		skipRemittanceReconciliation,
+		repairAddendaLength,
+		normalizeCharset,
 	}
 
 	for _, param := range validationNames {
@@ -420,6 +637,12 @@ func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
 				opts.SkipMandatoryIMAD = true
 			case allowMissingSenderSupplied:
 				opts.AllowMissingSenderSupplied = true
+			case skipRemittanceReconciliation:
+				opts.SkipRemittanceReconciliation = true
+			case repairAddendaLength:
+				opts.RepairAddendaLength = true
+			case normalizeCharset:
+				opts.NormalizeCharset = true
 			}
 		}
 	}
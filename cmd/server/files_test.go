@@ -49,7 +49,7 @@ func TestFiles_getFiles(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	req := httptest.NewRequest("GET", "/files", nil)
 
 	t.Run("retrieves file", func(t *testing.T) {
@@ -75,6 +75,42 @@ func TestFiles_getFiles(t *testing.T) {
 	})
 }
 
+func TestFiles_getFilesFilter(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	files := saveExportFiles(t, repo)
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	get := func(query string) (*httptest.ResponseRecorder, []*wire.File) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/files?"+query, nil))
+		w.Flush()
+
+		var out []*wire.File
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&out))
+		}
+		return w, out
+	}
+
+	w, found := get("typeCode=10&subTypeCode=00")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Len(t, found, 2)
+
+	w, found = get("inputCycleDate=20240212")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Len(t, found, 1)
+	require.Equal(t, files[0].ID, found[0].ID)
+
+	w, found = get("typeCode=16")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Empty(t, found)
+
+	// status needs the approval workflow
+	w, _ = get("status=approved")
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+}
+
 func readFile(filename string) (*wire.File, error) {
 	fd, err := os.Open(filepath.Join("..", "..", "test", "testdata", filename))
 	if err != nil {
@@ -87,7 +123,7 @@ func readFile(filename string) (*wire.File, error) {
 func TestFiles_createWithInterfaceData(t *testing.T) {
 	router := mux.NewRouter()
 	repo := &testWireFileRepository{}
-	addFileRoutes(log.NewTestLogger(), router, repo)
+	addFileRoutes(log.NewTestLogger(), router, repo, nil, nil)
 
 	w := httptest.NewRecorder()
 	raw := `FTI0811 XFT811  {1500}30        T {1510}1000{1520}20220128DOVTAL3C000001{2000}000000010000{3100}123456789DOVETAIL BANK US F*{3320}XX22012800000051*{3400}021000089CITIBANK NYC*{3600}CTP{3620}3*3AC4C307-0FFB-4028-BD8E-53D55BDB90E1*{3700}SUSD0,*{4200}D000100002*{5000}T000100011*DRESDEFFXXX*`
@@ -130,7 +166,7 @@ func TestFiles_createFile(t *testing.T) {
 	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("creates file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -156,10 +192,91 @@ func TestFiles_createFile(t *testing.T) {
 	})
 }
 
+func TestFiles_createFile_redactsLogs(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	bs = bytes.Replace(bs, []byte("{4200}31234*"), []byte("{4200}3123456789®*"), 1)
+
+	buf, logger := log.NewBufferLogger()
+	router := mux.NewRouter()
+	addFileRoutes(logger, router, &testWireFileRepository{}, nil, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs)))
+	w.Flush()
+
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), "123456789®")
+	require.Contains(t, buf.String(), "*****789®")
+	require.NotContains(t, buf.String(), "123456789")
+}
+
+func TestFiles_createFile_problem(t *testing.T) {
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, &testWireFileRepository{}, nil, nil)
+
+	decode := func(w *httptest.ResponseRecorder) validationProblem {
+		t.Helper()
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		require.Equal(t, "application/problem+json; charset=utf-8", w.Header().Get("Content-Type"))
+		var problem validationProblem
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&problem))
+		require.Equal(t, validationProblemType, problem.Type)
+		require.Equal(t, http.StatusBadRequest, problem.Status)
+		require.NotEmpty(t, problem.Detail)
+		require.Equal(t, problem.Detail, problem.Error)
+		return problem
+	}
+
+	t.Run("parse errors", func(t *testing.T) {
+		bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+		require.NoError(t, err)
+		bs = bytes.Replace(bs, []byte("{4200}31234*"), []byte("{4200}312®4*"), 1)
+		bs = bytes.Replace(bs, []byte("{2000}"), []byte("{2000}ABC"), 1)
+
+		w, _ := routerUploadRaw(t, router, bytes.NewReader(bs))
+		problem := decode(w)
+		require.Len(t, problem.Errors, 2)
+		require.Equal(t, wire.TagAmount, problem.Errors[0].Tag)
+		require.Equal(t, "/fedWireMessage/amount", problem.Errors[0].Pointer)
+		require.Equal(t, "/fedWireMessage/beneficiary/personal/identifier", problem.Errors[1].Pointer)
+		require.Equal(t, "W-4200-I-CHARSET", problem.Errors[1].Code)
+		require.Equal(t, "12®4", problem.Errors[1].Value)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+		require.NoError(t, err)
+		// a local instrument isn't permitted for CTR and {4100} is required
+		bs = bytes.Replace(bs, []byte("{3600}CTR   *\n"), []byte("{3600}CTR   *\n{3610}ANSI*\n"), 1)
+		bs = bytes.Replace(bs, []byte("{4100}D123456789*FI Name*Address One*Address Two*Address Three*\n"), nil, 1)
+
+		// every error is listed rather than the first
+		w, _ := routerUploadRaw(t, router, bytes.NewReader(bs))
+		problem := decode(w)
+		require.Len(t, problem.Errors, 2)
+		require.Equal(t, "W-3610-TAG-NOT-PERMITTED", problem.Errors[0].Code)
+		require.Equal(t, "/fedWireMessage/localInstrument", problem.Errors[0].Pointer)
+		require.Equal(t, "W-4100-TAG-REQUIRED", problem.Errors[1].Code)
+		require.Equal(t, "/fedWireMessage/beneficiaryFI", problem.Errors[1].Pointer)
+
+		// as are the errors of JSON files
+		f, err := wire.NewReader(bytes.NewReader(bs)).Read()
+		require.Error(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, json.NewEncoder(&buf).Encode(f))
+		req := httptest.NewRequest("POST", "/files/create", &buf)
+		req.Header.Set("Content-Type", "application/json")
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, problem.Errors, decode(w).Errors)
+	})
+}
+
 func TestFiles_createFileJSON(t *testing.T) {
-	repo := &testWireFileRepository{}
+	repo := newMemoryWireFileRepository()
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("creates file from JSON", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -198,6 +315,55 @@ func TestFiles_createFileJSON(t *testing.T) {
 		assert.Nil(t, resp.FEDWireMessage.ValidateOptions)
 	})
 
+	t.Run("existing ID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.json"))
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+		req.Header.Set("content-type", "application/json")
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		require.Equal(t, http.StatusConflict, w.Code, w.Body)
+		require.Contains(t, w.Body.String(), errFileExists.Error())
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-BankTransfer.json"))
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/files/create?strict=true", bytes.NewReader(bs))
+		req.Header.Set("content-type", "application/json")
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body)
+
+		bs = bytes.Replace(bs, []byte(`"businessFunctionCode": {`), []byte(`"businessFunctionCode": {"typo": "X", `), 1)
+		w = httptest.NewRecorder()
+		req = httptest.NewRequest("POST", "/files/create?strict=true", bytes.NewReader(bs))
+		req.Header.Set("content-type", "application/json")
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+		var problem validationProblem
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&problem))
+		require.Len(t, problem.Errors, 1)
+		require.Equal(t, "/fedWireMessage/businessFunctionCode/typo", problem.Errors[0].Pointer)
+		require.Equal(t, wire.RuleUnknownField, problem.Errors[0].Rule)
+		require.Equal(t, wire.TagBusinessFunctionCode, problem.Errors[0].Tag)
+		require.Equal(t, "W-3600-UNKNOWN-FIELD", problem.Errors[0].Code)
+
+		// empty keys are unknown too
+		bs = bytes.Replace(bs, []byte(`"typo"`), []byte(`""`), 1)
+		w = httptest.NewRecorder()
+		req = httptest.NewRequest("POST", "/files/create?strict=true", bytes.NewReader(bs))
+		req.Header.Set("content-type", "application/json")
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		require.Contains(t, w.Body.String(), "W-3600-UNKNOWN-FIELD")
+	})
+
 	t.Run("invalid JSON", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		req := httptest.NewRequest("POST", "/files/create", strings.NewReader(`{...invalid-json`))
@@ -210,10 +376,28 @@ func TestFiles_createFileJSON(t *testing.T) {
 	})
 }
 
+func TestFiles_getFileSchema(t *testing.T) {
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, &testWireFileRepository{}, nil, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/schema", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "application/schema+json", w.Header().Get("Content-Type"))
+
+	var schema struct {
+		Ref  string                     `json:"$ref"`
+		Defs map[string]json.RawMessage `json:"$defs"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&schema))
+	require.Equal(t, "#/$defs/File", schema.Ref)
+	require.Contains(t, schema.Defs, "PreviousMessageIdentifier")
+}
+
 func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	// set up a message with no SenderSupplied field
 	fwm := mockFEDWireMessage()
@@ -272,6 +456,42 @@ func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	assert.NotNil(t, resp.Body)
 }
 
+func TestFiles_createFile_repairAddendaLength(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransferPlusUnstructuredAddenda.txt"))
+	require.NoError(t, err)
+	bs = bytes.Replace(bs, []byte("{8200}0020"), []byte("{8200}0018"), 1)
+
+	// a mismatched AddendaLength is rejected by default
+	resp, _ := routerUploadRaw(t, router, bytes.NewReader(bs))
+	require.Equal(t, http.StatusBadRequest, resp.Code, resp.Body)
+
+	resp, uploaded := routerUploadRaw(t, router, bytes.NewReader(bs), setQueryParam("repairAddendaLength", "true"))
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body)
+	require.Equal(t, "0020", uploaded.FEDWireMessage.UnstructuredAddenda.AddendaLength)
+}
+
+func TestFiles_createFile_normalizeCharset(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	bs = bytes.Replace(bs, []byte("{4200}31234*Name*"), []byte("{4200}31234*José*"), 1)
+
+	resp, _ := routerUploadRaw(t, router, bytes.NewReader(bs))
+	require.Equal(t, http.StatusBadRequest, resp.Code, resp.Body)
+
+	resp, uploaded := routerUploadRaw(t, router, bytes.NewReader(bs), setQueryParam("normalizeCharset", "true"))
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body)
+	require.Equal(t, "Jose", uploaded.FEDWireMessage.Beneficiary.Personal.Name)
+	require.Equal(t, []string{`299 wire "{4200} beneficiary.personal.name normalized from \"Jos\u00e9\" to \"Jose\""`}, resp.Header().Values("Warning"))
+}
+
 func setQueryParam(key, value string) func(values url.Values) url.Values {
 	return func(values url.Values) url.Values {
 		values.Set(key, value)
@@ -350,7 +570,7 @@ func TestFiles_getFile(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("gets file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -364,6 +584,24 @@ func TestFiles_getFile(t *testing.T) {
 		assert.NotEmpty(t, file.ID)
 	})
 
+	t.Run("redacts file", func(t *testing.T) {
+		f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		require.NoError(t, err)
+		repo.file = f
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/files/foo?redact=true", nil))
+		w.Flush()
+		repo.file = &wire.File{ID: base.ID()}
+
+		assert.Equal(t, http.StatusOK, w.Code, w.Body)
+		var file wire.File
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&file))
+		require.Equal(t, "****", file.FEDWireMessage.Beneficiary.Personal.Identifier)
+		require.Equal(t, "***", file.FEDWireMessage.Beneficiary.Personal.Name)
+		require.Equal(t, "Name", f.FEDWireMessage.Beneficiary.Personal.Name)
+	})
+
 	t.Run("repo error", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		repo.err = errors.New("bad error")
@@ -390,7 +628,7 @@ func TestFiles_deleteFile(t *testing.T) {
 	req := httptest.NewRequest("DELETE", "/files/foo", nil)
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("deletes file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -423,7 +661,7 @@ func TestFiles_getFileContents(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("gets file contents", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -466,7 +704,7 @@ func TestFiles_getFileContentsWithFormatAndNewLineQueryParams(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	// test with no format no newline=false
 	req := httptest.NewRequest("GET", "/files/foo/contents", nil)
@@ -539,7 +777,7 @@ func TestFiles_validateFile(t *testing.T) {
 	require.NoError(t, err)
 	repo := &testWireFileRepository{file: f}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("validates file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -547,7 +785,37 @@ func TestFiles_validateFile(t *testing.T) {
 		router.ServeHTTP(w, req)
 		w.Flush()
 
-		assert.Contains(t, w.Body.String(), `{"error":null}`)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body)
+		assert.Contains(t, w.Body.String(), `{"error":null,"errors":[],"warnings":[]}`)
+	})
+
+	t.Run("errors and warnings", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		invalid, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		require.NoError(t, err)
+		invalid.FEDWireMessage.Beneficiary.Personal.Name = strings.Repeat("A", 40)
+		invalid.FEDWireMessage.Amount = nil
+		repo.file = invalid
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+		repo.file = f
+
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		var resp struct {
+			Error    string                 `json:"error"`
+			Errors   []wire.ValidationIssue `json:"errors"`
+			Warnings []wire.ValidationIssue `json:"warnings"`
+		}
+		require.Equal(t, "application/problem+json; charset=utf-8", w.Header().Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.NotEmpty(t, resp.Error)
+		require.Len(t, resp.Errors, 1)
+		require.Equal(t, "amount", resp.Errors[0].Field)
+		require.Equal(t, "/fedWireMessage/amount", resp.Errors[0].Pointer)
+		require.Equal(t, "W-2000-TAG-REQUIRED", resp.Errors[0].Code)
+		require.Len(t, resp.Warnings, 1)
+		require.Equal(t, wire.RuleTextTruncated, resp.Warnings[0].Rule)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -578,7 +846,7 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	fwm := mockFEDWireMessage()
 	repo := &testWireFileRepository{file: f}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("adds message to file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -637,7 +905,7 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	req := httptest.NewRequest("DELETE", fmt.Sprintf("/files/foo/FEDWireMessage/%s", FEDWireMessageID), nil)
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
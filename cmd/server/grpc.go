@@ -0,0 +1,439 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/wirepb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var errNoContent = errors.New("missing file or text")
+
+// grpcRoles are the roles required to call each method of WireService
+var grpcRoles = map[string]role{
+	wirepb.WireService_CreateFile_FullMethodName:    roleWrite,
+	wirepb.WireService_GetFile_FullMethodName:       roleRead,
+	wirepb.WireService_ValidateFile_FullMethodName:  roleRead,
+	wirepb.WireService_ConvertFile_FullMethodName:   roleRead,
+	wirepb.WireService_ValidateFiles_FullMethodName: roleRead,
+}
+
+// newGRPCServer returns a gRPC server for WireService which stores files in repo, like the HTTP routes.
+// Callers are authenticated by auth, when it's set, from the same credentials as HTTP requests.
+func newGRPCServer(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher, auth authenticator, opts ...grpc.ServerOption) *grpc.Server {
+	if auth != nil {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				ctx, err := grpcAuthenticate(ctx, logger, auth, info.FullMethod)
+				if err != nil {
+					return nil, err
+				}
+				return handler(ctx, req)
+			}),
+			grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				ctx, err := grpcAuthenticate(ss.Context(), logger, auth, info.FullMethod)
+				if err != nil {
+					return err
+				}
+				return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+			}),
+		)
+	}
+	server := grpc.NewServer(opts...)
+	wirepb.RegisterWireServiceServer(server, &wireService{
+		logger:   logger,
+		repo:     repo,
+		workflow: workflow,
+		events:   events,
+	})
+	return server
+}
+
+// grpcRequest returns an HTTP request carrying the metadata and client certificates of the gRPC call
+// in ctx, so calls are authenticated and stored the same way as HTTP requests
+func grpcRequest(ctx context.Context) *http.Request {
+	r, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", nil)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for k, values := range md {
+			for _, v := range values {
+				r.Header.Add(k, v)
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			r.TLS = &info.State
+		}
+	}
+	return r
+}
+
+// grpcAuthenticate returns ctx with the principal of the caller, who must have the role required by method
+func grpcAuthenticate(ctx context.Context, logger log.Logger, auth authenticator, method string) (context.Context, error) {
+	r := grpcRequest(ctx)
+	p, err := auth.authenticate(r)
+	if err != nil {
+		logger.Set("requestID", log.String(moovhttp.GetRequestID(r))).LogErrorf("authentication failed: %v", err)
+		return nil, status.Error(codes.Unauthenticated, errUnauthorized.Error())
+	}
+	if want := grpcRoles[method]; !p.hasRole(want) {
+		return nil, status.Errorf(codes.PermissionDenied, "%v: %s role required", errForbidden, want)
+	}
+	return withPrincipal(ctx, p), nil
+}
+
+// authenticatedStream replaces the context of a stream with one carrying the caller's principal
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// wireService implements WireService
+type wireService struct {
+	wirepb.UnimplementedWireServiceServer
+
+	logger   log.Logger
+	repo     WireFileRepository
+	workflow *approvalWorkflow
+	events   eventPublisher
+}
+
+func (s *wireService) requestLogger(r *http.Request, method string) log.Logger {
+	logger := s.logger.Set("method", log.String(method))
+	if requestID := moovhttp.GetRequestID(r); requestID != "" {
+		logger = logger.Set("requestID", log.String(requestID))
+	}
+	return logger
+}
+
+func (s *wireService) CreateFile(ctx context.Context, req *wirepb.CreateFileRequest) (*wirepb.File, error) {
+	r := grpcRequest(ctx)
+	logger := s.requestLogger(r, "CreateFile")
+
+	file, normalizations, err := readFileContent(ctx, req)
+	if file == nil {
+		err = logger.LogErrorf("error reading request: %v", err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		// list every error rather than the first
+		issues := readIssues(ctx, file, err)
+		recordFileInvalid(file, issues...)
+		err = logRedactedError(logger, "error reading file", err)
+		return nil, invalidFileStatus(err, issues)
+	}
+
+	if err := storeCreatedFile(r, logger, s.repo, s.workflow, s.events, file); err != nil {
+		return nil, storeFileStatus(err)
+	}
+	if len(normalizations) > 0 {
+		logger.Logf("normalized %d fields", len(normalizations))
+		grpc.SetHeader(ctx, normalizationWarnings(normalizations))
+	}
+	return fileToProto(file)
+}
+
+func (s *wireService) GetFile(ctx context.Context, req *wirepb.GetFileRequest) (*wirepb.File, error) {
+	r := grpcRequest(ctx)
+	logger := s.requestLogger(r, "GetFile").Set("fileID", log.String(req.GetFileId()))
+
+	file, err := s.repo.getFile(getTenantID(r), req.GetFileId())
+	if err != nil {
+		err = logger.LogErrorf("error retrieving file: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if file == nil {
+		logger.Log("file not found")
+		return nil, status.Error(codes.NotFound, "file not found")
+	}
+	if req.GetRedact() {
+		file = file.Redacted(wire.DefaultRedactionPolicy)
+	}
+	return fileToProto(file)
+}
+
+func (s *wireService) ValidateFile(ctx context.Context, req *wirepb.ValidateFileRequest) (*wirepb.ValidationResult, error) {
+	return s.validate(ctx, req, s.tenantFiles(ctx))
+}
+
+// ValidateFiles validates each file sent on stream. Invalid files are reported in their result, while
+// requests which can't be validated, such as those for a file which doesn't exist, end the stream.
+func (s *wireService) ValidateFiles(stream wirepb.WireService_ValidateFilesServer) error {
+	sent := s.tenantFiles(stream.Context())
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		result, err := s.validate(stream.Context(), req, sent)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+}
+
+// tenantFiles returns the files of the caller's tenant, loaded on first use and then kept, so stored
+// files validated on one stream aren't each compared against a fresh read of every file
+func (s *wireService) tenantFiles(ctx context.Context) func() ([]*wire.File, error) {
+	var (
+		files  []*wire.File
+		loaded bool
+	)
+	return func() ([]*wire.File, error) {
+		if loaded {
+			return files, nil
+		}
+		var err error
+		if files, err = s.repo.getFiles(getTenantID(grpcRequest(ctx))); err != nil {
+			return nil, err
+		}
+		loaded = true
+		return files, nil
+	}
+}
+
+// validate validates the file in req, or the stored file it names, and returns every error and warning
+func (s *wireService) validate(ctx context.Context, req *wirepb.ValidateFileRequest, sent func() ([]*wire.File, error)) (*wirepb.ValidationResult, error) {
+	r := grpcRequest(ctx)
+	logger := s.requestLogger(r, "ValidateFile")
+	if req.GetReference() != "" {
+		logger = logger.Set("reference", log.String(req.GetReference()))
+	}
+
+	if fileID := req.GetFileId(); fileID != "" {
+		return s.validateStoredFile(r, logger.Set("fileID", log.String(fileID)), req.GetReference(), fileID, sent)
+	}
+
+	file, _, err := readFileContent(ctx, req)
+	if file == nil {
+		err = logger.LogErrorf("error reading request: %v", err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if isParseError(err) {
+		issues := file.FEDWireMessage.IssuesFor(err)
+		recordFileInvalid(file, issues...)
+		logRedactedError(logger, "error reading file", err)
+		return validationResultToProto(req.GetReference(), &wire.ValidationResult{Errors: issues}), nil
+	}
+
+	result := file.ValidateDetailedWithContext(ctx)
+	if err := result.Err(); err != nil {
+		recordFileInvalid(file, result.Errors...)
+		logRedactedError(logger, "file was invalid", err)
+	} else {
+		recordFileValidated(file)
+		logger.Logf("validated file with %d warnings", len(result.Warnings))
+	}
+	return validationResultToProto(req.GetReference(), result), nil
+}
+
+// validateStoredFile validates a stored file against the others of its tenant, like GET /files/{fileID}/validate
+func (s *wireService) validateStoredFile(r *http.Request, logger log.Logger, reference, fileID string, sent func() ([]*wire.File, error)) (*wirepb.ValidationResult, error) {
+	file, err := s.repo.getFile(getTenantID(r), fileID)
+	if err != nil {
+		err = logger.LogErrorf("error retrieving file: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if file == nil {
+		logger.Log("file not found")
+		return nil, status.Error(codes.NotFound, "file not found")
+	}
+
+	// other files are checked for a reused SenderReference
+	others, err := sent()
+	if err != nil {
+		err = logger.LogErrorf("error retrieving files: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result, err := validateStoredFile(r, logger, s.events, fileID, file, others)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return validationResultToProto(reference, result), nil
+}
+
+func (s *wireService) ConvertFile(ctx context.Context, req *wirepb.ConvertFileRequest) (*wirepb.ConvertFileResponse, error) {
+	r := grpcRequest(ctx)
+	logger := s.requestLogger(r, "ConvertFile")
+
+	file, normalizations, err := readFileContent(ctx, req)
+	if file == nil {
+		err = logger.LogErrorf("error reading request: %v", err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		issues := readIssues(ctx, file, err)
+		err = logRedactedError(logger, "file was invalid", err)
+		return nil, invalidFileStatus(err, issues)
+	}
+	if len(normalizations) > 0 {
+		grpc.SetHeader(ctx, normalizationWarnings(normalizations))
+	}
+
+	if req.GetFormat() == wirepb.Format_FORMAT_FILE {
+		out, err := fileToProto(file)
+		if err != nil {
+			return nil, err
+		}
+		return &wirepb.ConvertFileResponse{Content: &wirepb.ConvertFileResponse_File{File: out}}, nil
+	}
+
+	var buf bytes.Buffer
+	opts := []wire.OptionFunc{wire.VariableLengthFields(req.GetFormat() == wirepb.Format_FORMAT_VARIABLE)}
+	if req.GetOmitNewlines() {
+		opts = append(opts, wire.NewlineCharacter(""))
+	}
+	if err := wire.NewWriter(&buf, opts...).Write(file); err != nil {
+		err = logger.LogErrorf("problem converting file: %v", err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &wirepb.ConvertFileResponse{Content: &wirepb.ConvertFileResponse_Text{Text: buf.String()}}, nil
+}
+
+// fileContent is a request carrying a file, as a File or Fedwire text, and the options to validate it with
+type fileContent interface {
+	GetFile() *wirepb.File
+	GetText() string
+	GetOptions() *wirepb.ValidateOpts
+}
+
+// readFileContent reads the file in req and validates it, like readFileBody does for HTTP requests.
+// Files which fail validation are returned along with the error, while requests without a file which
+// can be decoded return a nil file.
+func readFileContent(ctx context.Context, req fileContent) (*wire.File, []wire.Normalization, error) {
+	opts := validateOptsFromProto(req.GetOptions())
+	if req.GetFile() == nil {
+		if req.GetText() == "" {
+			return nil, nil, errNoContent
+		}
+		reader := wire.NewReader(strings.NewReader(req.GetText()))
+		file, err := reader.ReadWithContext(ctx, opts)
+		return &file, reader.Normalizations(), err
+	}
+
+	file, err := wire.FileFromProto(req.GetFile())
+	if err != nil {
+		return nil, nil, err
+	}
+	normalizations, err := checkFile(ctx, file, opts)
+	return file, normalizations, err
+}
+
+func validateOptsFromProto(opts *wirepb.ValidateOpts) *wire.ValidateOpts {
+	if opts == nil {
+		return nil
+	}
+	return &wire.ValidateOpts{
+		SkipMandatoryIMAD:            opts.GetSkipMandatoryImad(),
+		AllowMissingSenderSupplied:   opts.GetAllowMissingSenderSupplied(),
+		SkipRemittanceReconciliation: opts.GetSkipRemittanceReconciliation(),
+		RepairAddendaLength:          opts.GetRepairAddendaLength(),
+		NormalizeCharset:             opts.GetNormalizeCharset(),
+	}
+}
+
+// fileToProto converts file to its Protobuf message
+func fileToProto(file *wire.File) (*wirepb.File, error) {
+	out, err := wire.FileToProto(file)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return out, nil
+}
+
+func validationResultToProto(reference string, result *wire.ValidationResult) *wirepb.ValidationResult {
+	return &wirepb.ValidationResult{
+		Reference: reference,
+		Valid:     result.Valid(),
+		Errors:    issuesToProto(result.Errors),
+		Warnings:  issuesToProto(result.Warnings),
+	}
+}
+
+func issuesToProto(issues []wire.ValidationIssue) []*wirepb.ValidationIssue {
+	var out []*wirepb.ValidationIssue
+	for _, issue := range issues {
+		var value string
+		if issue.Value != nil {
+			value = fmt.Sprint(issue.Value)
+		}
+		out = append(out, &wirepb.ValidationIssue{
+			Code:    issue.Code,
+			Tag:     issue.Tag,
+			Field:   issue.Field,
+			Pointer: issue.Pointer,
+			Rule:    issue.Rule,
+			Message: issue.Message,
+			Value:   value,
+		})
+	}
+	return out
+}
+
+// invalidFileStatus returns an INVALID_ARGUMENT status for err with a BadRequest detail listing issues
+// by their JSON pointer, the gRPC counterpart of writeValidationProblem
+func invalidFileStatus(err error, issues []wire.ValidationIssue) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	badRequest := &errdetails.BadRequest{}
+	for _, issue := range issues {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       issue.Pointer,
+			Description: fmt.Sprintf("%s: %s", issue.Code, issue.Message),
+		})
+	}
+	if withDetails, err := st.WithDetails(badRequest); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// storeFileStatus returns the status of err from storeCreatedFile, the gRPC counterpart of workflowProblem
+func storeFileStatus(err error) error {
+	switch {
+	case errors.Is(err, errWorkflowNoUser):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, errFileExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// normalizationWarnings returns a warning header for each field changed by ValidateOpts.NormalizeCharset,
+// like addNormalizationWarnings
+func normalizationWarnings(normalizations []wire.Normalization) metadata.MD {
+	md := metadata.MD{}
+	for _, n := range normalizations {
+		md.Append("warning", fmt.Sprintf("%s %s normalized from %q to %q", n.Tag, n.Field, n.Original, n.Normalized))
+	}
+	return md
+}
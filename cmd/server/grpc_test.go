@@ -0,0 +1,355 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/wirepb"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// grpcClient returns a client of a WireService served in memory
+func grpcClient(t *testing.T, repo WireFileRepository, auth authenticator) wirepb.WireServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := newGRPCServer(log.NewNopLogger(), repo, nil, nil, auth)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return wirepb.NewWireServiceClient(conn)
+}
+
+func TestGRPC_createGetFile(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	client := grpcClient(t, repo, nil)
+	ctx := context.Background()
+
+	created, err := client.CreateFile(ctx, &wirepb.CreateFileRequest{
+		Content: &wirepb.CreateFileRequest_Text{Text: string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Id)
+	require.Equal(t, "CTR", created.FedWireMessage.BusinessFunctionCode.BusinessFunctionCode)
+
+	stored, err := repo.getFile("", created.Id)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+
+	got, err := client.GetFile(ctx, &wirepb.GetFileRequest{FileId: created.Id})
+	require.NoError(t, err)
+	file, err := wire.FileFromProto(got)
+	require.NoError(t, err)
+	require.Equal(t, stored, file)
+
+	redacted, err := client.GetFile(ctx, &wirepb.GetFileRequest{FileId: created.Id, Redact: true})
+	require.NoError(t, err)
+	require.NotEqual(t, stored.FEDWireMessage.Beneficiary.Personal.Name, redacted.FedWireMessage.Beneficiary.Personal.Name)
+
+	_, err = client.GetFile(ctx, &wirepb.GetFileRequest{FileId: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// files can be created from a File, but not over a stored one
+	_, err = client.CreateFile(ctx, &wirepb.CreateFileRequest{
+		Content: &wirepb.CreateFileRequest_File{File: got},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	got.Id = ""
+	created, err = client.CreateFile(ctx, &wirepb.CreateFileRequest{
+		Content: &wirepb.CreateFileRequest_File{File: got},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Id)
+
+	_, err = client.CreateFile(ctx, &wirepb.CreateFileRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_createFileInvalid(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	client := grpcClient(t, repo, nil)
+
+	bs := readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+	bs = bytes.Replace(bs, []byte("{4200}31234*"), []byte("{4200}312®4*"), 1)
+
+	_, err := client.CreateFile(context.Background(), &wirepb.CreateFileRequest{
+		Content: &wirepb.CreateFileRequest_Text{Text: string(bs)},
+	})
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest := st.Details()[0].(*errdetails.BadRequest)
+	require.Equal(t, "/fedWireMessage/beneficiary/personal/identifier", badRequest.FieldViolations[0].Field)
+	require.Contains(t, badRequest.FieldViolations[0].Description, "W-4200-I-CHARSET")
+
+	files, err := repo.getFiles("")
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	// validation options are honored
+	bs = readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+	bs = bytes.Replace(bs, []byte("{1520}20190410Source08000001\n"), nil, 1)
+	_, err = client.CreateFile(context.Background(), &wirepb.CreateFileRequest{
+		Content: &wirepb.CreateFileRequest_Text{Text: string(bs)},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateFile(context.Background(), &wirepb.CreateFileRequest{
+		Content: &wirepb.CreateFileRequest_Text{Text: string(bs)},
+		Options: &wirepb.ValidateOpts{SkipMandatoryImad: true},
+	})
+	require.NoError(t, err)
+}
+
+func TestGRPC_validateFile(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	client := grpcClient(t, repo, nil)
+	ctx := context.Background()
+
+	file, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	file.ID = "stored"
+	require.NoError(t, repo.saveFile("", file))
+
+	result, err := client.ValidateFile(ctx, &wirepb.ValidateFileRequest{
+		Content:   &wirepb.ValidateFileRequest_FileId{FileId: "stored"},
+		Reference: "ref-1",
+	})
+	require.NoError(t, err)
+	require.True(t, result.Valid)
+	require.Equal(t, "ref-1", result.Reference)
+
+	_, err = client.ValidateFile(ctx, &wirepb.ValidateFileRequest{
+		Content: &wirepb.ValidateFileRequest_FileId{FileId: "missing"},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	pb, err := fileToProto(file)
+	require.NoError(t, err)
+	pb.FedWireMessage.BeneficiaryFi = nil
+	result, err = client.ValidateFile(ctx, &wirepb.ValidateFileRequest{
+		Content: &wirepb.ValidateFileRequest_File{File: pb},
+	})
+	require.NoError(t, err)
+	require.False(t, result.Valid)
+	require.Equal(t, "W-4100-TAG-REQUIRED", result.Errors[0].Code)
+	require.Equal(t, "/fedWireMessage/beneficiaryFI", result.Errors[0].Pointer)
+
+	// nothing is stored
+	files, err := repo.getFiles("")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
+
+func TestGRPC_validateFiles(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	client := grpcClient(t, repo, nil)
+
+	valid := string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))
+	invalid := strings.Replace(valid, "{4200}31234*", "{4200}312®4*", 1)
+
+	stream, err := client.ValidateFiles(context.Background())
+	require.NoError(t, err)
+	for i, text := range []string{valid, invalid, valid} {
+		require.NoError(t, stream.Send(&wirepb.ValidateFileRequest{
+			Content:   &wirepb.ValidateFileRequest_Text{Text: text},
+			Reference: []string{"a", "b", "c"}[i],
+		}))
+	}
+	require.NoError(t, stream.CloseSend())
+
+	var results []*wirepb.ValidationResult
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		results = append(results, result)
+	}
+	require.Len(t, results, 3)
+	require.Equal(t, "a", results[0].Reference)
+	require.True(t, results[0].Valid)
+	require.Equal(t, "b", results[1].Reference)
+	require.False(t, results[1].Valid)
+	require.Equal(t, "W-4200-I-CHARSET", results[1].Errors[0].Code)
+	require.True(t, results[2].Valid)
+
+	// requests which can't be validated end the stream
+	stream, err = client.ValidateFiles(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&wirepb.ValidateFileRequest{}))
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// countingWireFileRepository counts how often every file of a tenant is read
+type countingWireFileRepository struct {
+	WireFileRepository
+
+	listed int
+}
+
+func (r *countingWireFileRepository) getFiles(tenantID string) ([]*wire.File, error) {
+	r.listed++
+	return r.WireFileRepository.getFiles(tenantID)
+}
+
+func TestGRPC_validateStoredFiles(t *testing.T) {
+	repo := &countingWireFileRepository{WireFileRepository: newMemoryWireFileRepository()}
+	client := grpcClient(t, repo, nil)
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		created, err := client.CreateFile(context.Background(), &wirepb.CreateFileRequest{
+			Content: &wirepb.CreateFileRequest_Text{Text: string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))},
+		})
+		require.NoError(t, err)
+		ids = append(ids, created.Id)
+	}
+	repo.listed = 0
+
+	stream, err := client.ValidateFiles(context.Background())
+	require.NoError(t, err)
+	for _, id := range ids {
+		require.NoError(t, stream.Send(&wirepb.ValidateFileRequest{
+			Content: &wirepb.ValidateFileRequest_FileId{FileId: id},
+		}))
+	}
+	require.NoError(t, stream.CloseSend())
+
+	var results int
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		results++
+	}
+	require.Equal(t, 3, results)
+	require.Equal(t, 1, repo.listed)
+}
+
+func TestGRPC_convertFile(t *testing.T) {
+	client := grpcClient(t, newMemoryWireFileRepository(), nil)
+	ctx := context.Background()
+
+	text := string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))
+	resp, err := client.ConvertFile(ctx, &wirepb.ConvertFileRequest{
+		Content: &wirepb.ConvertFileRequest_Text{Text: text},
+		Format:  wirepb.Format_FORMAT_FILE,
+	})
+	require.NoError(t, err)
+	pb := resp.GetFile()
+	require.Equal(t, "CTR", pb.FedWireMessage.BusinessFunctionCode.BusinessFunctionCode)
+
+	resp, err = client.ConvertFile(ctx, &wirepb.ConvertFileRequest{
+		Content: &wirepb.ConvertFileRequest_File{File: pb},
+	})
+	require.NoError(t, err)
+	file, err := wire.NewReader(strings.NewReader(resp.GetText())).Read()
+	require.NoError(t, err)
+	require.Equal(t, "CTR", file.FEDWireMessage.BusinessFunctionCode.BusinessFunctionCode)
+
+	resp, err = client.ConvertFile(ctx, &wirepb.ConvertFileRequest{
+		Content:      &wirepb.ConvertFileRequest_File{File: pb},
+		Format:       wirepb.Format_FORMAT_VARIABLE,
+		OmitNewlines: true,
+	})
+	require.NoError(t, err)
+	require.NotContains(t, resp.GetText(), "\n")
+	require.Contains(t, resp.GetText(), "*")
+
+	_, err = client.ConvertFile(ctx, &wirepb.ConvertFileRequest{
+		Content: &wirepb.ConvertFileRequest_Text{Text: strings.Replace(text, "{4200}31234*", "{4200}312®4*", 1)},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_auth(t *testing.T) {
+	auth, err := newAPIKeyAuthenticator([]identity{
+		{Key: "reader", UserID: "svc", Tenant: "acme", Roles: []string{"read"}},
+		{Key: "writer", UserID: "svc", Tenant: "acme", Roles: []string{"read", "write"}},
+	})
+	require.NoError(t, err)
+
+	repo := newMemoryWireFileRepository()
+	client := grpcClient(t, repo, auth)
+	text := string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))
+	req := &wirepb.CreateFileRequest{
+		Content: &wirepb.CreateFileRequest_Text{Text: text},
+	}
+
+	_, err = client.CreateFile(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "reader")
+	_, err = client.CreateFile(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer writer")
+	created, err := client.CreateFile(ctx, req)
+	require.NoError(t, err)
+
+	// files are stored for the caller's tenant
+	stored, err := repo.getFile("acme", created.Id)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "reader")
+	_, err = client.GetFile(ctx, &wirepb.GetFileRequest{FileId: created.Id})
+	require.NoError(t, err)
+
+	// streams are authenticated too
+	stream, err := client.ValidateFiles(context.Background())
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestFileProto(t *testing.T) {
+	for _, name := range []string{
+		"fedWireMessage-CustomerTransferPlusCOVS.txt",
+		"fedWireMessage-CustomerTransferPlusStructuredRemittance.txt",
+		"fedWireMessage-ServiceMessage.txt",
+	} {
+		t.Run(name, func(t *testing.T) {
+			file, err := readFile(name)
+			require.NoError(t, err)
+
+			pb, err := fileToProto(file)
+			require.NoError(t, err)
+			got, err := wire.FileFromProto(pb)
+			require.NoError(t, err)
+			require.Equal(t, file, got)
+		})
+	}
+}
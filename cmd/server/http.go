@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/go-kit/kit/metrics/prometheus"
+	"github.com/gorilla/mux"
 	moovhttp "github.com/moov-io/base/http"
 	"github.com/moov-io/base/log"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
@@ -23,6 +24,22 @@ var (
 )
 
 func wrapResponseWriter(logger log.Logger, w http.ResponseWriter, r *http.Request) http.ResponseWriter {
-	route := fmt.Sprintf("%s%s", strings.ToLower(r.Method), strings.Replace(r.URL.Path, "/", "-", -1)) // TODO: filter out random ID's later
-	return moovhttp.Wrap(logger, routeHistogram.With("route", route), w, r)
+	return moovhttp.Wrap(logger, routeHistogram.With("route", routeName(r)), w, r)
+}
+
+// routeName returns the metric label of the route r matched, such as get-files-{fileID}. The path
+// template is used rather than the path so IDs don't create a label each.
+func routeName(r *http.Request) string {
+	return fmt.Sprintf("%s%s", strings.ToLower(r.Method), strings.Replace(routeTemplate(r), "/", "-", -1))
+}
+
+// routeTemplate returns the path template of the route r matched, such as /files/{fileID}, or the
+// path when r didn't match a route
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return r.URL.Path
 }
@@ -0,0 +1,490 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// maxImportSize is the largest request body accepted by POST /files/import, and the most a zip
+	// archive may hold once decompressed
+	maxImportSize = 50 * 1024 * 1024
+
+	// maxImportEntries is the most entries a zip archive may hold
+	maxImportEntries = 10000
+)
+
+var (
+	errImportTooLarge       = fmt.Errorf("import is larger than %d bytes", maxImportSize)
+	errImportTooManyEntries = fmt.Errorf("zip archive has more than %d entries", maxImportEntries)
+	errImportEmpty          = errors.New("import contains no messages")
+
+	// messageStartRegex matches SenderSupplied, which begins every Fedwire message
+	messageStartRegex = regexp.MustCompile(regexp.QuoteMeta(wire.TagSenderSupplied))
+	messageTagRegex   = regexp.MustCompile(`^{[0-9]{4}}`)
+)
+
+// importItem is the outcome of importing one message
+type importItem struct {
+	// Source is the zip entry the message was read from
+	Source string `json:"source,omitempty"`
+	// Line is the line of the upload (or zip entry) where the message starts
+	Line   int    `json:"line"`
+	FileID string `json:"fileID,omitempty"`
+	Error  string `json:"error,omitempty"`
+	// Warnings are the fields changed when normalizeCharset is set
+	Warnings []wire.Normalization `json:"warnings,omitempty"`
+}
+
+type importReport struct {
+	Created int          `json:"created"`
+	Failed  int          `json:"failed"`
+	Items   []importItem `json:"items"`
+}
+
+// importedMessage is a message read from an upload which hasn't been stored yet
+type importedMessage struct {
+	source string
+	line   int
+	file   *wire.File
+	err    error
+	// normalizations are the fields changed by ValidateOpts.NormalizeCharset
+	normalizations []wire.Normalization
+}
+
+// importFiles stores every valid message from a Fedwire text stream, JSON array of files or
+// zip archive of .txt and .json files. Invalid messages are reported but don't stop the import.
+func importFiles(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		if err := workflow.canCreate(getUserID(r)); err != nil {
+			moovhttp.Problem(w, logger.LogError(err).Err())
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxImportSize+1))
+		if err != nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if len(body) > maxImportSize {
+			moovhttp.Problem(w, logger.LogError(errImportTooLarge).Err())
+			return
+		}
+
+		messages, err := readImport(r.Context(), body, r.Header.Get("Content-Type"), validateOptsFromQuery(r.URL.Query()))
+		if err == nil && len(messages) == 0 {
+			err = errImportEmpty
+		}
+		if err != nil {
+			err = logRedactedError(logger, "error reading import", err)
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		report := storeImported(r, logger, repo, workflow, events, messages)
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(report)
+	}
+}
+
+// importCSV stores a message for every valid row of a CSV file, such as a payment run exported from a
+// spreadsheet. The multipart form holds the CSV file and the wire.CSVMapping of its columns, in YAML or
+// JSON. Items are reported with the line of their row.
+func importCSV(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		if err := workflow.canCreate(getUserID(r)); err != nil {
+			moovhttp.Problem(w, logger.LogError(err).Err())
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		data, err := readFormPart(r, "mapping")
+		if err != nil {
+			moovhttp.Problem(w, logger.LogError(err).Err())
+			return
+		}
+		mapping, err := wire.ParseCSVMapping(data)
+		if err != nil {
+			moovhttp.Problem(w, logger.LogError(err).Err())
+			return
+		}
+		if mapping.ValidateOptions == nil {
+			mapping.ValidateOptions = validateOptsFromQuery(r.URL.Query())
+		}
+
+		data, err = readFormPart(r, "file")
+		if err != nil {
+			moovhttp.Problem(w, logger.LogError(err).Err())
+			return
+		}
+		rows, err := wire.ReadCSV(bytes.NewReader(data), mapping)
+		if err == nil && len(rows) == 0 {
+			err = errImportEmpty
+		}
+		if err != nil {
+			err = logRedactedError(logger, "error reading CSV", err)
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		messages := make([]importedMessage, 0, len(rows))
+		for _, row := range rows {
+			messages = append(messages, importedMessage{
+				line:           row.Row,
+				file:           row.File,
+				err:            row.Err,
+				normalizations: row.Normalizations,
+			})
+		}
+		report := storeImported(r, logger, repo, workflow, events, messages)
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(report)
+	}
+}
+
+// readFormPart returns the contents of the file uploaded as name in a multipart form, or its value
+// when it was sent as a field
+func readFormPart(r *http.Request, name string) ([]byte, error) {
+	f, _, err := r.FormFile(name)
+	if errors.Is(err, http.ErrMissingFile) {
+		if value := r.FormValue(name); value != "" {
+			return []byte(value), nil
+		}
+		return nil, fmt.Errorf("missing %s in form", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// storeImported stores each valid message, reporting the outcome of every message
+func storeImported(r *http.Request, logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher, messages []importedMessage) importReport {
+	report := importReport{
+		Items: make([]importItem, 0, len(messages)),
+	}
+	for _, msg := range messages {
+		item := importItem{
+			Source:   msg.source,
+			Line:     msg.line,
+			Warnings: msg.normalizations,
+		}
+		if msg.err != nil {
+			recordFileError(msg.file, msg.err)
+		} else {
+			msg.err = storeCreatedFile(r, logger, repo, workflow, events, msg.file)
+		}
+		if msg.err != nil {
+			item.Error = msg.err.Error()
+			report.Failed++
+		} else {
+			item.FileID = msg.file.ID
+			report.Created++
+		}
+		report.Items = append(report.Items, item)
+	}
+	logger.Logf("imported %d files, %d failed", report.Created, report.Failed)
+	trace.SpanFromContext(r.Context()).SetAttributes(
+		wire.AttributeMessageCount.Int(len(messages)),
+		wire.AttributeErrorCount.Int(report.Failed),
+	)
+	return report
+}
+
+// createFileMu serializes storeCreatedFile so two files can't be created with the same ID
+var createFileMu sync.Mutex
+
+// storeCreatedFile saves a new file along with its workflow and records that it was created.
+// Files sent with the ID of a stored file are rejected with errFileExists rather than replacing it.
+func storeCreatedFile(r *http.Request, logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher, file *wire.File) error {
+	clientID := file.ID != ""
+	if !clientID {
+		file.ID = base.ID()
+	}
+	logger = logger.Set("fileID", log.String(file.ID))
+
+	if err := workflow.canCreate(getUserID(r)); err != nil {
+		return logger.LogError(err).Err()
+	}
+
+	createFileMu.Lock()
+	var err error
+	if clientID {
+		var existing *wire.File
+		if existing, err = repo.getFile(getTenantID(r), file.ID); err == nil && existing != nil {
+			err = errFileExists
+		}
+	}
+	if err == nil {
+		err = repo.saveFile(getTenantID(r), file)
+	}
+	createFileMu.Unlock()
+	if errors.Is(err, errFileExists) {
+		return logger.LogError(err).Err()
+	}
+	if err != nil {
+		return logger.LogErrorf("problem saving file: %v", err).Err()
+	}
+	if err := workflow.created(getTenantID(r), file.ID, getUserID(r)); err != nil {
+		return logger.LogErrorf("problem saving file workflow: %v", err).Err()
+	}
+	logger.Log("created file")
+
+	recordFileCreated(file)
+
+	publishFileEvent(r.Context(), logger, events, newFileEvent(eventFileCreated, getTenantID(r), file.ID, file))
+	return nil
+}
+
+// readImport splits body into messages based on its content type, falling back to sniffing the content.
+func readImport(ctx context.Context, body []byte, contentType string, opts *wire.ValidateOpts) ([]importedMessage, error) {
+	switch {
+	case strings.Contains(contentType, "zip") || bytes.HasPrefix(body, []byte("PK\x03\x04")):
+		return readImportZip(ctx, body, opts)
+	case strings.Contains(contentType, "json"):
+		return readImportJSON(ctx, "", body, opts)
+	case !strings.Contains(contentType, "text/plain") && looksLikeJSON(body):
+		return readImportJSON(ctx, "", body, opts)
+	}
+	return readImportText(ctx, "", body, opts), nil
+}
+
+// looksLikeJSON reports if body is a JSON array or object rather than a Fedwire message,
+// which also starts with a brace.
+func looksLikeJSON(body []byte) bool {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return false
+	}
+	return body[0] == '[' || (body[0] == '{' && !messageTagRegex.Match(body))
+}
+
+func readImportZip(ctx context.Context, body []byte, opts *wire.ValidateOpts) ([]importedMessage, error) {
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return nil, fmt.Errorf("problem reading zip: %v", err)
+	}
+
+	if len(zr.File) > maxImportEntries {
+		return nil, errImportTooManyEntries
+	}
+
+	// guard against zip bombs by capping the bytes decompressed across every entry
+	remaining := int64(maxImportSize)
+
+	var out []importedMessage
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		ext := strings.ToLower(path.Ext(f.Name))
+		if ext != ".txt" && ext != ".json" {
+			out = append(out, importedMessage{
+				source: f.Name,
+				err:    fmt.Errorf("unsupported file extension %q", ext),
+			})
+			continue
+		}
+
+		contents, err := readZipFile(f, remaining)
+		if errors.Is(err, errImportTooLarge) {
+			return nil, err
+		}
+		if err != nil {
+			out = append(out, importedMessage{source: f.Name, err: err})
+			continue
+		}
+		remaining -= int64(len(contents))
+
+		if ext == ".json" {
+			messages, err := readImportJSON(ctx, f.Name, contents, opts)
+			if err != nil {
+				out = append(out, importedMessage{source: f.Name, line: 1, err: err})
+				continue
+			}
+			out = append(out, messages...)
+		} else {
+			out = append(out, readImportText(ctx, f.Name, contents, opts)...)
+		}
+	}
+	return out, nil
+}
+
+// readZipFile decompresses f, returning errImportTooLarge when it's larger than limit bytes
+func readZipFile(f *zip.File, limit int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	// the uncompressed size in the header can't be trusted
+	bs, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(bs)) > limit {
+		return nil, errImportTooLarge
+	}
+	return bs, nil
+}
+
+// readImportJSON reads a JSON array of files, or a single file. Each element is decoded
+// on its own so one malformed file doesn't prevent the others from being imported.
+func readImportJSON(ctx context.Context, source string, body []byte, opts *wire.ValidateOpts) ([]importedMessage, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		line := lineAt(body, len(body)-len(bytes.TrimLeft(body, " \t\r\n")))
+		return []importedMessage{decodeImportedFile(ctx, source, line, body, opts)}, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if _, err := dec.Token(); err != nil { // opening [
+		return nil, err
+	}
+
+	var out []importedMessage
+	for dec.More() {
+		var raw json.RawMessage
+		offset := int(dec.InputOffset())
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineAt(body, offset), err)
+		}
+		// skip the separator and whitespace preceding the element
+		start := offset + bytes.IndexFunc(body[offset:], func(r rune) bool {
+			return r != ',' && r != ' ' && r != '\t' && r != '\r' && r != '\n'
+		})
+		out = append(out, decodeImportedFile(ctx, source, lineAt(body, start), raw, opts))
+	}
+	return out, nil
+}
+
+func decodeImportedFile(ctx context.Context, source string, line int, raw []byte, opts *wire.ValidateOpts) importedMessage {
+	msg := importedMessage{
+		source: source,
+		line:   line,
+		file:   wire.NewFile(),
+	}
+	if err := json.Unmarshal(raw, msg.file); err != nil {
+		msg.err = err
+		return msg
+	}
+	msg.normalizations, msg.err = checkFile(ctx, msg.file, opts)
+	return msg
+}
+
+// readImportText splits a Fedwire text stream into messages, each starting with a {1500} tag,
+// and parses each message on its own.
+func readImportText(ctx context.Context, source string, body []byte, opts *wire.ValidateOpts) []importedMessage {
+	type chunk struct {
+		line int
+		buf  strings.Builder
+	}
+	var chunks []*chunk
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportSize)
+
+	lineNum := 0
+	appendTo := func(s string) {
+		if len(chunks) == 0 {
+			// content before the first message is parsed (and rejected) on its own
+			if strings.TrimSpace(s) == "" {
+				return
+			}
+			chunks = append(chunks, &chunk{line: lineNum})
+		}
+		chunks[len(chunks)-1].buf.WriteString(s)
+	}
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		// a line can hold several messages when tags aren't separated by newlines
+		last := 0
+		for _, idx := range messageStartRegex.FindAllStringIndex(line, -1) {
+			appendTo(line[last:idx[0]])
+			chunks = append(chunks, &chunk{line: lineNum})
+			last = idx[0]
+		}
+		appendTo(line[last:] + "\n")
+	}
+
+	out := make([]importedMessage, 0, len(chunks))
+	for _, c := range chunks {
+		msg := importedMessage{
+			source: source,
+			line:   c.line,
+		}
+		reader := wire.NewReader(strings.NewReader(c.buf.String()))
+		file, err := reader.ReadWithContext(ctx, opts)
+		if err != nil {
+			msg.err = err
+		} else {
+			msg.file = &file
+		}
+		msg.normalizations = reader.Normalizations()
+		out = append(out, msg)
+	}
+	if err := scanner.Err(); err != nil {
+		out = append(out, importedMessage{source: source, line: lineNum + 1, err: err})
+	}
+	return out
+}
+
+// lineAt returns the 1-based line number of offset within body
+func lineAt(body []byte, offset int) int {
+	if offset > len(body) {
+		offset = len(body)
+	}
+	return bytes.Count(body[:offset], []byte("\n")) + 1
+}
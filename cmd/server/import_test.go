@@ -0,0 +1,300 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func routerImport(t *testing.T, router *mux.Router, contentType string, body []byte) (*httptest.ResponseRecorder, importReport) {
+	t.Helper()
+
+	req := httptest.NewRequest("POST", "/files/import", bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	var report importReport
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+	}
+	return w, report
+}
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+	require.NoError(t, err)
+	return bs
+}
+
+func TestFiles_importText(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	events := &memoryPublisher{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, events)
+
+	valid := readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+	invalid := readTestdata(t, "fedWireMessage-MissingRequiredTag.txt")
+	// single line messages are split on {1500} as well
+	fiserv := readTestdata(t, "fedWireMessage-fiserv.txt")
+
+	var body bytes.Buffer
+	body.Write(valid)
+	body.WriteString("\n\n")
+	body.Write(invalid)
+	body.WriteString("\n")
+	body.Write(fiserv)
+
+	w, report := routerImport(t, router, "text/plain", body.Bytes())
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, 2, report.Created)
+	require.Equal(t, 1, report.Failed)
+	require.Len(t, report.Items, 3)
+
+	validLines := bytes.Count(valid, []byte("\n"))
+	invalidLines := bytes.Count(invalid, []byte("\n"))
+
+	require.Equal(t, 1, report.Items[0].Line)
+	require.NotEmpty(t, report.Items[0].FileID)
+	require.Empty(t, report.Items[0].Error)
+
+	require.Equal(t, validLines+3, report.Items[1].Line)
+	require.Empty(t, report.Items[1].FileID)
+	require.NotEmpty(t, report.Items[1].Error)
+
+	require.Equal(t, validLines+invalidLines+4, report.Items[2].Line)
+	require.NotEmpty(t, report.Items[2].FileID)
+
+	files, err := repo.getFiles("")
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Len(t, events.published(), 2)
+}
+
+func TestFiles_importJSON(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(mockFEDWireMessage())
+	valid, err := json.Marshal(file)
+	require.NoError(t, err)
+
+	body := "[\n  " + string(valid) + ",\n  {\"fedWireMessage\": {}},\n  " + string(valid) + "\n]"
+
+	w, report := routerImport(t, router, "application/json", []byte(body))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, 2, report.Created)
+	require.Equal(t, 1, report.Failed)
+
+	require.Equal(t, 2, report.Items[0].Line)
+	require.Equal(t, 3, report.Items[1].Line)
+	require.NotEmpty(t, report.Items[1].Error)
+	require.Equal(t, 4, report.Items[2].Line)
+
+	// malformed JSON is rejected outright
+	w, _ = routerImport(t, router, "application/json", []byte(`[{"id": `))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+}
+
+func TestFiles_importJSONValidateOpts(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	file := wire.NewFile()
+	fwm := mockFEDWireMessage()
+	fwm.InputMessageAccountabilityData = nil
+	file.AddFEDWireMessage(fwm)
+	body, err := json.Marshal(file)
+	require.NoError(t, err)
+
+	w, report := routerImport(t, router, "application/json", body)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, 1, report.Failed)
+
+	req := httptest.NewRequest("POST", "/files/import?skipMandatoryIMAD=true", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+	require.Equal(t, 1, report.Created, report.Items)
+}
+
+func TestFiles_importNormalizeCharset(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	file := wire.NewFile()
+	fwm := mockFEDWireMessage()
+	fwm.Beneficiary.Personal.Name = "Zoë"
+	file.AddFEDWireMessage(fwm)
+	body, err := json.Marshal(file)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/files/import?normalizeCharset=true", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+
+	var report importReport
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+	require.Equal(t, 1, report.Created)
+	require.Equal(t, []wire.Normalization{{
+		Tag:        wire.TagBeneficiary,
+		Field:      "beneficiary.personal.name",
+		Original:   "Zoë",
+		Normalized: "Zoe",
+	}}, report.Items[0].Warnings)
+}
+
+func TestFiles_importZip(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	add := func(name string, contents []byte) {
+		f, err := zw.Create(name)
+		require.NoError(t, err)
+		f.Write(contents)
+	}
+	add("batch/transfer.txt", readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))
+	add("batch/drawdown.json", readTestdata(t, "fedWireMessage-BankDrawDownRequest.json"))
+	add("batch/notes.md", []byte("# notes"))
+	require.NoError(t, zw.Close())
+
+	w, report := routerImport(t, router, "application/zip", buf.Bytes())
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, 2, report.Created)
+	require.Equal(t, 1, report.Failed)
+
+	require.Equal(t, "batch/transfer.txt", report.Items[0].Source)
+	require.Equal(t, "batch/drawdown.json", report.Items[1].Source)
+	require.Equal(t, "batch/notes.md", report.Items[2].Source)
+	require.Contains(t, report.Items[2].Error, "unsupported file extension")
+
+	// content is sniffed when the type isn't given, and the JSON file's ID is already stored
+	w, report = routerImport(t, router, "", buf.Bytes())
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, 1, report.Created)
+	require.Equal(t, errFileExists.Error(), report.Items[1].Error)
+}
+
+func TestFiles_importZipLimits(t *testing.T) {
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, newMemoryWireFileRepository(), nil, nil)
+
+	zipOf := func(entries int, contents []byte) []byte {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for i := 0; i < entries; i++ {
+			f, err := zw.Create(fmt.Sprintf("%d.txt", i))
+			require.NoError(t, err)
+			f.Write(contents)
+		}
+		require.NoError(t, zw.Close())
+		return buf.Bytes()
+	}
+
+	// the entries together decompress to more than the limit, though each is under it
+	w, _ := routerImport(t, router, "application/zip", zipOf(2, bytes.Repeat([]byte("\n"), maxImportSize/2+1)))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), errImportTooLarge.Error())
+
+	w, _ = routerImport(t, router, "application/zip", zipOf(maxImportEntries+1, nil))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), errImportTooManyEntries.Error())
+}
+
+func TestFiles_importEmpty(t *testing.T) {
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, newMemoryWireFileRepository(), nil, nil)
+
+	w, _ := routerImport(t, router, "text/plain", []byte("\n \n"))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	require.True(t, strings.Contains(w.Body.String(), errImportEmpty.Error()))
+}
+
+func TestFiles_importCSV(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	upload := func(mapping, csv []byte) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, err := form.CreateFormFile("mapping", "mapping.yaml")
+		require.NoError(t, err)
+		part.Write(mapping)
+		part, err = form.CreateFormFile("file", "payments.csv")
+		require.NoError(t, err)
+		part.Write(csv)
+		require.NoError(t, form.Close())
+
+		req := httptest.NewRequest("POST", "/files/import/csv", &body)
+		req.Header.Set("Content-Type", form.FormDataContentType())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	mapping := readTestdata(t, "payments-mapping.yaml")
+	csv := readTestdata(t, "payments.csv")
+	// a fourth row without an amount
+	csv = append(csv, []byte("CTR,PAY-0004,,231380104,Citadel,123456789,Jane Doe,2 Elm Street\n")...)
+
+	w := upload(mapping, csv)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	var report importReport
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+	require.Equal(t, 3, report.Created)
+	require.Equal(t, 1, report.Failed)
+	require.Len(t, report.Items, 4)
+	require.Equal(t, 2, report.Items[0].Line)
+	require.NotEmpty(t, report.Items[0].FileID)
+	require.Equal(t, 5, report.Items[3].Line)
+	require.Contains(t, report.Items[3].Error, "row 5: ")
+
+	file, err := repo.getFile("", report.Items[1].FileID)
+	require.NoError(t, err)
+	require.Equal(t, "PAY-0002", file.FEDWireMessage.SenderReference.SenderReference)
+
+	t.Run("invalid mapping", func(t *testing.T) {
+		w := upload([]byte("columns: {Type: businessFunctionCode.code}"), csv)
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		require.Contains(t, w.Body.String(), "businessFunctionCode.code is not a field")
+	})
+
+	t.Run("missing columns", func(t *testing.T) {
+		w := upload(mapping, []byte("Type,Amount\nCTR,000000000100\n"))
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		require.Contains(t, w.Body.String(), "CSV header is missing columns")
+	})
+}
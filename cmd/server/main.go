@@ -9,6 +9,7 @@ import (
 	"crypto/tls"
 	"flag"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -23,11 +24,15 @@ import (
 	moovhttp "github.com/moov-io/base/http"
 	"github.com/moov-io/base/http/bind"
 	"github.com/moov-io/wire"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var (
 	httpAddr  = flag.String("http.addr", bind.HTTP("wire"), "HTTP listen address")
 	adminAddr = flag.String("admin.addr", bind.Admin("wire"), "Admin HTTP listen address")
+	grpcAddr  = flag.String("grpc.addr", "", "gRPC listen address, such as :9088, empty to disable")
 
 	flagLogFormat = flag.String("log.format", "", "Format for log lines (Options: json, plain")
 )
@@ -75,15 +80,65 @@ func main() {
 	}()
 	defer adminServer.Shutdown()
 
-	repo := &memoryWireFileRepository{
-		files: make(map[string]*wire.File),
+	repo, err := newEncryptedWireFileRepositoryFromEnv(logger, newMemoryWireFileRepository())
+	if err != nil {
+		logger.LogErrorf("problem reading encryption config: %v", err)
+		return
+	}
+	if encrypted, ok := repo.(*encryptedWireFileRepository); ok {
+		logger.Log("encryption at rest enabled")
+		adminServer.AddHandler("/files/reencrypt", reencryptFiles(logger, encrypted))
+	}
+
+	workflow, err := newApprovalWorkflowFromEnv()
+	if err != nil {
+		logger.LogErrorf("problem reading approval workflow config: %v", err)
+		return
+	}
+	if workflow != nil {
+		logger.Log("maker-checker approval workflow enabled")
+	}
+
+	events, closeEvents, err := newEventPublisherFromEnv(logger)
+	if err != nil {
+		logger.LogErrorf("problem reading webhook config: %v", err)
+		return
+	}
+	defer closeEvents()
+
+	auth, err := newAuthenticatorFromEnv()
+	if err != nil {
+		logger.LogErrorf("problem reading authentication config: %v", err)
+		return
+	}
+
+	tracerProvider, err := newTracerProviderFromEnv(context.Background())
+	if err != nil {
+		logger.LogErrorf("problem reading tracing config: %v", err)
+		return
+	}
+	if tracerProvider != nil {
+		defer func() {
+			if err := tracerProvider.Shutdown(context.Background()); err != nil {
+				logger.LogErrorf("problem flushing traces: %v", err)
+			}
+		}()
 	}
 
 	// Setup business HTTP routes
 	router := mux.NewRouter()
 	moovhttp.AddCORSHandler(router)
+	if tracerProvider != nil {
+		logger.Log("tracing enabled")
+		router.Use(tracingMiddleware())
+	}
+	if auth != nil {
+		logger.Log("authentication enabled")
+		router.Use(authMiddleware(logger, auth))
+	}
 	addPingRoute(router)
-	addFileRoutes(logger, router, repo)
+	addFileRoutes(logger, router, repo, workflow, events)
+	addTemplateRoutes(logger, router, newMemoryTemplateRepository(), repo, workflow, events)
 
 	// Start business HTTP server
 	readTimeout, _ := time.ParseDuration("30s")
@@ -103,12 +158,51 @@ func main() {
 		WriteTimeout:      writTimeout,
 		IdleTimeout:       idleTimeout,
 	}
+	if path := os.Getenv("HTTPS_CLIENT_CA_FILE"); path != "" {
+		pool, err := readClientCAs(path)
+		if err != nil {
+			logger.LogErrorf("problem reading client CAs: %v", err)
+			return
+		}
+		// client certificates are optional at the TLS layer so API keys and JWTs keep working,
+		// authMiddleware rejects requests without any credentials
+		serve.TLSConfig.ClientCAs = pool
+		serve.TLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
 	shutdownServer := func() {
 		if err := serve.Shutdown(context.TODO()); err != nil {
 			logger.LogErrorf("shutdown error: %v", err)
 		}
 	}
 
+	// Start gRPC server, which shares file storage with the HTTP routes
+	if *grpcAddr != "" {
+		var opts []grpc.ServerOption
+		if certFile, keyFile := os.Getenv("HTTPS_CERT_FILE"), os.Getenv("HTTPS_KEY_FILE"); certFile != "" && keyFile != "" {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				logger.LogErrorf("problem reading TLS certificate: %v", err)
+				return
+			}
+			config := serve.TLSConfig.Clone()
+			config.Certificates = []tls.Certificate{cert}
+			opts = append(opts, grpc.Creds(credentials.NewTLS(config)))
+		}
+		grpcServer := newGRPCServer(logger, repo, workflow, events, auth, opts...)
+		listener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			logger.LogErrorf("problem binding gRPC server: %v", err)
+			return
+		}
+		go func() {
+			logger.Logf("binding to %s for gRPC server", *grpcAddr)
+			if err := grpcServer.Serve(listener); err != nil {
+				logger.LogErrorf("grpc server error: %v", err)
+			}
+		}()
+		defer grpcServer.GracefulStop()
+	}
+
 	// Start business logic HTTP server
 	go func() {
 		if certFile, keyFile := os.Getenv("HTTPS_CERT_FILE"), os.Getenv("HTTPS_KEY_FILE"); certFile != "" && keyFile != "" {
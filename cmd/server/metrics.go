@@ -0,0 +1,100 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strconv"
+
+	"github.com/moov-io/wire"
+)
+
+// businessFunctionCodes are the business_function_code label values, others are counted as "other"
+var businessFunctionCodes = map[string]bool{
+	wire.BankTransfer:                     true,
+	wire.CheckSameDaySettlement:           true,
+	wire.CustomerTransferPlus:             true,
+	wire.CustomerTransfer:                 true,
+	wire.DepositSendersAccount:            true,
+	wire.BankDrawDownRequest:              true,
+	wire.CustomerCorporateDrawdownRequest: true,
+	wire.DrawdownResponse:                 true,
+	wire.FEDFundsReturned:                 true,
+	wire.FEDFundsSold:                     true,
+	wire.BFCServiceMessage:                true,
+}
+
+// typeCodes and subTypeCodes make up the type_subtype label values, others are counted as "other"
+var (
+	typeCodes = map[string]bool{
+		wire.FundsTransfer:      true,
+		wire.ForeignTransfer:    true,
+		wire.SettlementTransfer: true,
+	}
+	subTypeCodes = map[string]bool{
+		wire.BasicFundsTransfer:              true,
+		wire.RequestReversal:                 true,
+		wire.ReversalTransfer:                true,
+		wire.RequestReversalPriorDayTransfer: true,
+		wire.ReversalPriorDayTransfer:        true,
+		wire.RequestCredit:                   true,
+		wire.FundsTransferRequestCredit:      true,
+		wire.RefusalRequestCredit:            true,
+		wire.SSIServiceMessage:               true,
+	}
+)
+
+// fileLabels returns the business function code and type/subtype labels of file's metrics. Codes
+// outside the Fedwire code lists are labeled "other" so invalid files can't add label values.
+func fileLabels(file *wire.File) []string {
+	bfc, typeSubType := "unknown", "unknown"
+	if file != nil {
+		if fwm := file.FEDWireMessage; fwm.BusinessFunctionCode != nil && fwm.BusinessFunctionCode.BusinessFunctionCode != "" {
+			bfc = fwm.BusinessFunctionCode.BusinessFunctionCode
+			if !businessFunctionCodes[bfc] {
+				bfc = "other"
+			}
+		}
+		if fwm := file.FEDWireMessage; fwm.TypeSubType != nil && fwm.TypeSubType.TypeCode != "" {
+			typeSubType = fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
+			if !typeCodes[fwm.TypeSubType.TypeCode] || !subTypeCodes[fwm.TypeSubType.SubTypeCode] {
+				typeSubType = "other"
+			}
+		}
+	}
+	return []string{"business_function_code", bfc, "type_subtype", typeSubType}
+}
+
+// recordFileCreated counts file as created and observes its amount
+func recordFileCreated(file *wire.File) {
+	labels := fileLabels(file)
+	filesCreated.With(labels...).Add(1)
+
+	if amount := file.FEDWireMessage.Amount; amount != nil {
+		if cents, err := strconv.ParseInt(amount.Amount, 10, 64); err == nil {
+			fileAmounts.With(labels[:2]...).Observe(float64(cents) / 100)
+		}
+	}
+}
+
+// recordFileValidated counts file as passing validation
+func recordFileValidated(file *wire.File) {
+	filesValidated.With(fileLabels(file)...).Add(1)
+}
+
+// recordFileInvalid counts file, which may be partially read, as failing validation with issues
+func recordFileInvalid(file *wire.File, issues ...wire.ValidationIssue) {
+	filesInvalid.With(fileLabels(file)...).Add(1)
+	for _, issue := range issues {
+		validationFailures.With("rule_code", issue.Code).Add(1)
+	}
+}
+
+// recordFileError counts file as failing validation with err, returned by Validate or Reader.Read
+func recordFileError(file *wire.File, err error) {
+	if file == nil {
+		file = &wire.File{}
+	}
+	recordFileInvalid(file, file.FEDWireMessage.IssueFor(err))
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+// metricValue returns the value of the counter, or the sample count of the histogram, called name with
+// labels from the default registry
+func metricValue(t *testing.T, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := stdprometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, metric := range family.GetMetric() {
+			for _, pair := range metric.GetLabel() {
+				if labels[pair.GetName()] != pair.GetValue() {
+					continue metrics
+				}
+			}
+			if metric.GetHistogram() != nil {
+				return float64(metric.GetHistogram().GetSampleCount())
+			}
+			return metric.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+func TestRouteName(t *testing.T) {
+	var route string
+	router := mux.NewRouter()
+	router.Methods("GET").Path("/files/{fileID}/contents").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route = routeName(r)
+	})
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/files/3f2d23ee214/contents", nil))
+	require.Equal(t, "get-files-{fileID}-contents", route)
+
+	require.Equal(t, "post-files-create", routeName(httptest.NewRequest("POST", "/files/create", nil)))
+}
+
+func TestFileMetrics(t *testing.T) {
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, newMemoryWireFileRepository(), nil, nil)
+	ctr := map[string]string{"business_function_code": "CTR", "type_subtype": "1000"}
+
+	created := metricValue(t, "wire_files_created", ctr)
+	amounts := metricValue(t, "wire_file_amount_dollars", map[string]string{"business_function_code": "CTR"})
+	bs := readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+	w, _ := routerUploadRaw(t, router, bytes.NewReader(bs))
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	require.Equal(t, created+1, metricValue(t, "wire_files_created", ctr))
+	require.Equal(t, amounts+1, metricValue(t, "wire_file_amount_dollars", map[string]string{"business_function_code": "CTR"}))
+
+	invalid := metricValue(t, "wire_files_invalid", ctr)
+	failures := metricValue(t, "wire_validation_failures", map[string]string{"rule_code": "W-4200-I-CHARSET"})
+	bs = bytes.Replace(bs, []byte("{4200}31234*"), []byte("{4200}312®4*"), 1)
+	w, _ = routerUploadRaw(t, router, bytes.NewReader(bs))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	require.Equal(t, invalid+1, metricValue(t, "wire_files_invalid", ctr))
+	require.Equal(t, failures+1, metricValue(t, "wire_validation_failures", map[string]string{"rule_code": "W-4200-I-CHARSET"}))
+}
+
+func TestRecordFileError(t *testing.T) {
+	labels := map[string]string{"business_function_code": "unknown", "type_subtype": "unknown"}
+	invalid := metricValue(t, "wire_files_invalid", labels)
+
+	recordFileError(nil, errors.New("bad"))
+	require.Equal(t, invalid+1, metricValue(t, "wire_files_invalid", labels))
+	require.Equal(t, []string{"business_function_code", "unknown", "type_subtype", "unknown"}, fileLabels(&wire.File{}))
+}
+
+func TestFileLabels(t *testing.T) {
+	file := wire.NewFile()
+	file.FEDWireMessage.BusinessFunctionCode = &wire.BusinessFunctionCode{BusinessFunctionCode: wire.CustomerTransfer}
+	file.FEDWireMessage.TypeSubType = &wire.TypeSubType{TypeCode: wire.FundsTransfer, SubTypeCode: wire.BasicFundsTransfer}
+	require.Equal(t, []string{"business_function_code", "CTR", "type_subtype", "1000"}, fileLabels(file))
+
+	// codes outside the code lists don't become label values
+	file.FEDWireMessage.BusinessFunctionCode.BusinessFunctionCode = "X9Z"
+	file.FEDWireMessage.TypeSubType.SubTypeCode = "77"
+	require.Equal(t, []string{"business_function_code", "other", "type_subtype", "other"}, fileLabels(file))
+}
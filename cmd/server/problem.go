@@ -0,0 +1,57 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/moov-io/wire"
+)
+
+// validationProblemType identifies problems which list the errors of an invalid file. It links to the
+// rule codes of each error.
+const validationProblemType = "https://moov-io.github.io/wire/usage-go/#validation"
+
+// validationProblem is an RFC 7807 problem detail (application/problem+json) listing every error which
+// makes a file invalid, with the tag, JSON pointer, rule code and value of each.
+type validationProblem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail"`
+	// Error is the first error, as sent before problem details were returned
+	Error    string                 `json:"error"`
+	Errors   []wire.ValidationIssue `json:"errors"`
+	Warnings []wire.ValidationIssue `json:"warnings,omitempty"`
+}
+
+// writeValidationProblem responds with a validationProblem for err, the first of issues
+func writeValidationProblem(w http.ResponseWriter, err error, issues, warnings []wire.ValidationIssue) {
+	w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(validationProblem{
+		Type:     validationProblemType,
+		Title:    "File is invalid",
+		Status:   http.StatusBadRequest,
+		Detail:   err.Error(),
+		Error:    err.Error(),
+		Errors:   issues,
+		Warnings: warnings,
+	})
+}
+
+// readIssues describes err, returned by readFileBody for file. Files which were read but failed
+// validation are validated again, in a span which is a child of any span in ctx, so every error is
+// listed rather than the first.
+func readIssues(ctx context.Context, file *wire.File, err error) []wire.ValidationIssue {
+	if !isParseError(err) {
+		if result := file.ValidateDetailedWithContext(ctx); !result.Valid() {
+			return result.Errors
+		}
+	}
+	return file.FEDWireMessage.IssuesFor(err)
+}
@@ -6,56 +6,64 @@ import (
 	"sync"
 )
 
+// WireFileRepository stores files per tenant. Callers only see files saved under their own tenantID.
 type WireFileRepository interface {
-	getFiles() ([]*wire.File, error)
-	getFile(fileId string) (*wire.File, error)
+	getFiles(tenantID string) ([]*wire.File, error)
+	getFile(tenantID, fileId string) (*wire.File, error)
 
-	saveFile(file *wire.File) error
-	deleteFile(fileId string) error
+	saveFile(tenantID string, file *wire.File) error
+	deleteFile(tenantID, fileId string) error
 }
 
 type memoryWireFileRepository struct {
 	mu    sync.Mutex
-	files map[string]*wire.File
+	files map[string]map[string]*wire.File
 }
 
-func (r *memoryWireFileRepository) getFiles() ([]*wire.File, error) {
+func newMemoryWireFileRepository() *memoryWireFileRepository {
+	return &memoryWireFileRepository{
+		files: make(map[string]map[string]*wire.File),
+	}
+}
+
+func (r *memoryWireFileRepository) getFiles(tenantID string) ([]*wire.File, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	var out []*wire.File
-	for _, v := range r.files {
+	for _, v := range r.files[tenantID] {
 		f := *v
 		out = append(out, &f)
 	}
 	return out, nil
 }
 
-func (r *memoryWireFileRepository) getFile(fileId string) (*wire.File, error) {
+func (r *memoryWireFileRepository) getFile(tenantID, fileId string) (*wire.File, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	for i := range r.files {
-		if r.files[i].ID == fileId {
-			f := *r.files[i]
-			return &f, nil
-		}
+	if file, ok := r.files[tenantID][fileId]; ok {
+		f := *file
+		return &f, nil
 	}
 	return nil, nil
 }
 
-func (r *memoryWireFileRepository) saveFile(file *wire.File) error {
+func (r *memoryWireFileRepository) saveFile(tenantID string, file *wire.File) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if file.ID == "" {
 		return errors.New("empty Wire File ID")
 	}
-	r.files[file.ID] = file
+	if r.files[tenantID] == nil {
+		r.files[tenantID] = make(map[string]*wire.File)
+	}
+	r.files[tenantID][file.ID] = file
 	return nil
 }
 
-func (r *memoryWireFileRepository) deleteFile(fileId string) error {
+func (r *memoryWireFileRepository) deleteFile(tenantID, fileId string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -63,7 +71,7 @@ func (r *memoryWireFileRepository) deleteFile(fileId string) error {
 		return errors.New("empty Wire File Id")
 	}
 
-	delete(r.files, fileId)
+	delete(r.files[tenantID], fileId)
 
 	return nil
 }
@@ -12,37 +12,35 @@ type testWireFileRepository struct {
 	file *wire.File
 }
 
-func (r *testWireFileRepository) getFiles() ([]*wire.File, error) {
+func (r *testWireFileRepository) getFiles(tenantID string) ([]*wire.File, error) {
 	if r.err != nil {
 		return nil, r.err
 	}
 	return []*wire.File{r.file}, nil
 }
 
-func (r *testWireFileRepository) getFile(fileId string) (*wire.File, error) {
+func (r *testWireFileRepository) getFile(tenantID, fileId string) (*wire.File, error) {
 	if r.err != nil {
 		return nil, r.err
 	}
 	return r.file, nil
 }
 
-func (r *testWireFileRepository) saveFile(file *wire.File) error {
+func (r *testWireFileRepository) saveFile(tenantID string, file *wire.File) error {
 	if r.err == nil { // only persist if we're not error'ing
 		r.file = file
 	}
 	return r.err
 }
 
-func (r *testWireFileRepository) deleteFile(fileId string) error {
+func (r *testWireFileRepository) deleteFile(tenantID, fileId string) error {
 	return r.err
 }
 
 func TestMemoryStorage(t *testing.T) {
-	repo := &memoryWireFileRepository{
-		files: make(map[string]*wire.File),
-	}
+	repo := newMemoryWireFileRepository()
 
-	files, err := repo.getFiles()
+	files, err := repo.getFiles("")
 	if err != nil || len(files) != 0 {
 		t.Errorf("files=%#v error=%v", files, err)
 	}
@@ -53,16 +51,16 @@ func TestMemoryStorage(t *testing.T) {
 	}
 	f.ID = base.ID()
 
-	if err := repo.saveFile(f); err != nil {
+	if err := repo.saveFile("", f); err != nil {
 		t.Fatal(err)
 	}
 
-	files, err = repo.getFiles()
+	files, err = repo.getFiles("")
 	if err != nil || len(files) != 1 {
 		t.Errorf("files=%#v error=%v", files, err)
 	}
 
-	file, err := repo.getFile(f.ID)
+	file, err := repo.getFile("", f.ID)
 	if err != nil {
 		t.Error(err)
 	}
@@ -70,10 +68,10 @@ func TestMemoryStorage(t *testing.T) {
 		t.Errorf("file mis-match")
 	}
 
-	if err := repo.deleteFile(f.ID); err != nil {
+	if err := repo.deleteFile("", f.ID); err != nil {
 		t.Error(err)
 	}
-	files, err = repo.getFiles()
+	files, err = repo.getFiles("")
 	if err != nil || len(files) != 0 {
 		t.Errorf("files=%#v error=%v", files, err)
 	}
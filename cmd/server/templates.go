@@ -0,0 +1,339 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+var (
+	errNoTemplateId = errors.New("no Template ID found")
+)
+
+// templateRepository stores message templates per tenant. Callers only see templates saved under their
+// own tenantID.
+type templateRepository interface {
+	getTemplates(tenantID string) ([]*wire.Template, error)
+	getTemplate(tenantID, templateID string) (*wire.Template, error)
+
+	saveTemplate(tenantID string, template *wire.Template) error
+	deleteTemplate(tenantID, templateID string) error
+}
+
+type memoryTemplateRepository struct {
+	mu        sync.Mutex
+	templates map[string]map[string]*wire.Template
+}
+
+func newMemoryTemplateRepository() *memoryTemplateRepository {
+	return &memoryTemplateRepository{
+		templates: make(map[string]map[string]*wire.Template),
+	}
+}
+
+func (r *memoryTemplateRepository) getTemplates(tenantID string) ([]*wire.Template, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]*wire.Template, 0, len(r.templates[tenantID]))
+	for _, v := range r.templates[tenantID] {
+		t := *v
+		out = append(out, &t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (r *memoryTemplateRepository) getTemplate(tenantID, templateID string) (*wire.Template, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if template, ok := r.templates[tenantID][templateID]; ok {
+		t := *template
+		return &t, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryTemplateRepository) saveTemplate(tenantID string, template *wire.Template) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if template.ID == "" {
+		return errors.New("empty Template ID")
+	}
+	if r.templates[tenantID] == nil {
+		r.templates[tenantID] = make(map[string]*wire.Template)
+	}
+	r.templates[tenantID][template.ID] = template
+	return nil
+}
+
+func (r *memoryTemplateRepository) deleteTemplate(tenantID, templateID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if templateID == "" {
+		return errors.New("empty Template ID")
+	}
+	delete(r.templates[tenantID], templateID)
+	return nil
+}
+
+func addTemplateRoutes(logger log.Logger, r *mux.Router, templates templateRepository, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher) {
+	r.Methods("GET").Path("/templates").HandlerFunc(requireRole(roleRead, getTemplates(logger, templates)))
+	r.Methods("POST").Path("/templates").HandlerFunc(requireRole(roleWrite, saveTemplate(logger, templates, false)))
+	r.Methods("GET").Path("/templates/{templateId}").HandlerFunc(requireRole(roleRead, getTemplate(logger, templates)))
+	r.Methods("PUT").Path("/templates/{templateId}").HandlerFunc(requireRole(roleWrite, saveTemplate(logger, templates, true)))
+	r.Methods("DELETE").Path("/templates/{templateId}").HandlerFunc(requireRole(roleWrite, deleteTemplate(logger, templates)))
+	r.Methods("POST").Path("/templates/{templateId}/instantiate").HandlerFunc(requireRole(roleWrite, instantiateTemplate(logger, templates, repo, workflow, events)))
+}
+
+func getTemplateId(w http.ResponseWriter, r *http.Request) string {
+	v, ok := mux.Vars(r)["templateId"]
+	if !ok || v == "" {
+		moovhttp.Problem(w, errNoTemplateId)
+		return ""
+	}
+	return v
+}
+
+// templateResponse is a template along with the names of its placeholders
+type templateResponse struct {
+	*wire.Template
+	Placeholders []string `json:"placeholders"`
+}
+
+func newTemplateResponse(template *wire.Template) templateResponse {
+	return templateResponse{
+		Template:     template,
+		Placeholders: template.Placeholders(),
+	}
+}
+
+func getTemplates(logger log.Logger, templates templateRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		list, err := templates.getTemplates(getTenantID(r))
+		if err != nil {
+			err = logger.LogErrorf("error getting templates: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		out := make([]templateResponse, 0, len(list))
+		for _, template := range list {
+			out = append(out, newTemplateResponse(template))
+		}
+
+		w.Header().Set("X-Total-Count", fmt.Sprintf("%d", len(out)))
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(out)
+	}
+}
+
+// saveTemplate creates a template, or replaces the template named in the path when replace is set
+func saveTemplate(logger log.Logger, templates templateRepository, replace bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		var template wire.Template
+		if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if err := template.Validate(); err != nil {
+			moovhttp.Problem(w, logger.LogError(err).Err())
+			return
+		}
+
+		status := http.StatusCreated
+		if replace {
+			templateId := getTemplateId(w, r)
+			if templateId == "" {
+				logger.LogError(errNoTemplateId)
+				return
+			}
+			existing, err := templates.getTemplate(getTenantID(r), templateId)
+			if err != nil {
+				err = logger.LogErrorf("error retrieving template: %v", err).Err()
+				moovhttp.Problem(w, err)
+				return
+			}
+			if existing == nil {
+				logger.Log("template not found")
+				http.NotFound(w, r)
+				return
+			}
+			template.ID = templateId
+			status = http.StatusOK
+		} else {
+			template.ID = base.ID()
+		}
+		logger = logger.Set("templateID", log.String(template.ID))
+
+		if err := templates.saveTemplate(getTenantID(r), &template); err != nil {
+			err = logger.LogErrorf("problem saving template: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		logger.Log("saved template")
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(newTemplateResponse(&template))
+	}
+}
+
+func getTemplate(logger log.Logger, templates templateRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		template, ok := readTemplate(w, r, logger, templates)
+		if !ok {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newTemplateResponse(template))
+	}
+}
+
+func deleteTemplate(logger log.Logger, templates templateRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		templateId := getTemplateId(w, r)
+		if templateId == "" {
+			logger.LogError(errNoTemplateId)
+			return
+		}
+		logger = logger.Set("templateID", log.String(templateId))
+
+		if err := templates.deleteTemplate(getTenantID(r), templateId); err != nil {
+			err = logger.LogErrorf("error deleting template: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		logger.Log("deleted template")
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+
+		type response struct {
+			Error error `json:"error"`
+		}
+		json.NewEncoder(w).Encode(&response{Error: nil})
+	}
+}
+
+// instantiateRequest holds the values of the placeholders of a template
+type instantiateRequest struct {
+	Values map[string]string `json:"values"`
+}
+
+// instantiateTemplate creates a file from a template rendered with the values in the request body.
+// Rendered messages which are invalid are described by a validationProblem.
+func instantiateTemplate(logger log.Logger, templates templateRepository, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		var req instantiateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		template, ok := readTemplate(w, r, logger, templates)
+		if !ok {
+			return
+		}
+		logger = logger.Set("templateID", log.String(template.ID))
+
+		file, err := template.Render(req.Values)
+		if file == nil {
+			err = logger.LogErrorf("problem rendering template: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if err != nil {
+			// list every error rather than the first
+			issues := readIssues(r.Context(), file, err)
+			recordFileInvalid(file, issues...)
+			annotateFileSpan(r, file, len(issues))
+			err = logRedactedError(logger, "rendered file was invalid", err)
+			writeValidationProblem(w, err, issues, nil)
+			return
+		}
+
+		annotateFileSpan(r, file, 0)
+		if err := storeCreatedFile(r, logger, repo, workflow, events, file); err != nil {
+			workflowProblem(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(file)
+	}
+}
+
+// readTemplate returns the template named in the path of r, responding with an error when it can't
+func readTemplate(w http.ResponseWriter, r *http.Request, logger log.Logger, templates templateRepository) (*wire.Template, bool) {
+	templateId := getTemplateId(w, r)
+	if templateId == "" {
+		logger.LogError(errNoTemplateId)
+		return nil, false
+	}
+	logger = logger.Set("templateID", log.String(templateId))
+
+	template, err := templates.getTemplate(getTenantID(r), templateId)
+	if err != nil {
+		err = logger.LogErrorf("error retrieving template: %v", err).Err()
+		moovhttp.Problem(w, err)
+		return nil, false
+	}
+	if template == nil {
+		logger.Log("template not found")
+		http.NotFound(w, r)
+		return nil, false
+	}
+	return template, true
+}
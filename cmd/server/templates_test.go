@@ -0,0 +1,128 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplates(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	addTemplateRoutes(log.NewNopLogger(), router, newMemoryTemplateRepository(), repo, nil, nil)
+
+	do := func(method, path string, body interface{}) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		if body != nil {
+			require.NoError(t, json.NewEncoder(&buf).Encode(body))
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, path, &buf))
+		return w
+	}
+
+	file, err := wire.FileFromJSON(readTestdata(t, "fedWireMessage-CustomerTransfer.json"))
+	require.NoError(t, err)
+	file.FEDWireMessage.Amount.Amount = "{{amount}}"
+	file.FEDWireMessage.SenderReference.SenderReference = "{{senderReference}}"
+
+	// create
+	w := do("POST", "/templates", wire.Template{Name: "Monthly rent", FEDWireMessage: file.FEDWireMessage})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	var created templateResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+	require.NotEmpty(t, created.ID)
+	require.Equal(t, []string{"amount", "senderReference"}, created.Placeholders)
+
+	w = do("POST", "/templates", wire.Template{})
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	// list and get
+	w = do("GET", "/templates", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "1", w.Header().Get("X-Total-Count"))
+
+	w = do("GET", "/templates/"+created.ID, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	var got templateResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	require.Equal(t, "{{amount}}", got.FEDWireMessage.Amount.Amount)
+
+	// replace
+	file.FEDWireMessage.BeneficiaryReference.BeneficiaryReference = "{{invoice}}"
+	w = do("PUT", "/templates/"+created.ID, wire.Template{Name: "Monthly rent", FEDWireMessage: file.FEDWireMessage})
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	require.Equal(t, created.ID, got.ID)
+	require.Equal(t, []string{"amount", "invoice", "senderReference"}, got.Placeholders)
+
+	w = do("PUT", "/templates/missing", wire.Template{Name: "Missing"})
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body)
+
+	// instantiate
+	path := fmt.Sprintf("/templates/%s/instantiate", created.ID)
+	w = do("POST", path, instantiateRequest{Values: map[string]string{
+		"amount":          "000000250000",
+		"senderReference": "RENT-2024-05",
+		"invoice":         "INV-1001",
+	}})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	var instance wire.File
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&instance))
+	require.Equal(t, "RENT-2024-05", instance.FEDWireMessage.SenderReference.SenderReference)
+	stored, err := repo.getFile("", instance.ID)
+	require.NoError(t, err)
+	require.Equal(t, "INV-1001", stored.FEDWireMessage.BeneficiaryReference.BeneficiaryReference)
+
+	t.Run("missing values", func(t *testing.T) {
+		w := do("POST", path, instantiateRequest{Values: map[string]string{"amount": "000000250000"}})
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		require.Contains(t, w.Body.String(), "no value for placeholder {{senderReference}}")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		w := do("POST", path, instantiateRequest{Values: map[string]string{
+			"amount":          "12.50",
+			"senderReference": "RENT-2024-05",
+			"invoice":         "INV-1001",
+		}})
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		var problem validationProblem
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&problem))
+		require.Equal(t, "/fedWireMessage/amount/amount", problem.Errors[0].Pointer)
+	})
+
+	// delete
+	w = do("DELETE", "/templates/"+created.ID, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	w = do("GET", "/templates/"+created.ID, nil)
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body)
+	w = do("POST", path, instantiateRequest{})
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body)
+}
+
+func TestTemplates_tenants(t *testing.T) {
+	templates := newMemoryTemplateRepository()
+	require.NoError(t, templates.saveTemplate("a", &wire.Template{ID: "1", Name: "One"}))
+
+	got, err := templates.getTemplate("b", "1")
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	list, err := templates.getTemplates("b")
+	require.NoError(t, err)
+	require.Empty(t, list)
+	require.EqualError(t, templates.saveTemplate("a", &wire.Template{}), "empty Template ID")
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/moov-io/wire"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracingExporterOTLP   = "otlp"
+	tracingExporterStdout = "stdout"
+)
+
+// newTracerProviderFromEnv installs an OpenTelemetry TracerProvider when WIRE_TRACING_EXPORTER is set,
+// exporting spans with OTLP over HTTP (configured by the standard OTEL_EXPORTER_OTLP_* variables) or as
+// JSON to stdout. W3C trace context and baggage are read from incoming requests. A nil provider is
+// returned when tracing is disabled.
+func newTracerProviderFromEnv(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch name := strings.ToLower(strings.TrimSpace(os.Getenv("WIRE_TRACING_EXPORTER"))); name {
+	case "":
+		return nil, nil
+	case tracingExporterOTLP:
+		exporter, err = otlptracehttp.New(ctx)
+	case tracingExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown WIRE_TRACING_EXPORTER %q", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating %s trace exporter: %w", os.Getenv("WIRE_TRACING_EXPORTER"), err)
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override these
+	res, err := resource.Merge(
+		resource.NewSchemaless(
+			attribute.String("service.name", "wire"),
+			attribute.String("service.version", wire.Version),
+		),
+		resource.Environment(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider, nil
+}
+
+// tracingMiddleware starts a server span for each request, named by the route matched, which continues
+// any trace propagated by the caller. Handlers add their own attributes with trace.SpanFromContext.
+func tracingMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := otel.Tracer("github.com/moov-io/wire/cmd/server").Start(ctx,
+				r.Method+" "+routeTemplate(r),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.method", r.Method),
+					attribute.String("http.route", routeTemplate(r)),
+				),
+			)
+			defer span.End()
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r.WithContext(ctx))
+
+			span.SetAttributes(attribute.Int("http.status_code", sw.status))
+			if sw.status >= 500 {
+				span.SetStatus(codes.Error, http.StatusText(sw.status))
+			}
+		})
+	}
+}
+
+// statusWriter records the status code written to a response
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// annotateFileSpan adds the business function code and number of errors of file to the span of r
+func annotateFileSpan(r *http.Request, file *wire.File, errors int) {
+	bfc := ""
+	if file != nil && file.FEDWireMessage.BusinessFunctionCode != nil {
+		bfc = file.FEDWireMessage.BusinessFunctionCode.BusinessFunctionCode
+	}
+	trace.SpanFromContext(r.Context()).SetAttributes(
+		wire.AttributeBusinessFunctionCode.String(bfc),
+		wire.AttributeErrorCount.Int(errors),
+	)
+}
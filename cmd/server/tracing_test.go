@@ -0,0 +1,95 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+	return recorder
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	recorder := recordSpans(t)
+
+	router := mux.NewRouter()
+	router.Use(tracingMiddleware())
+	addFileRoutes(log.NewNopLogger(), router, newMemoryWireFileRepository(), nil, nil)
+
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(readTestdata(t, "fedWireMessage-CustomerTransfer.txt")))
+	req.Header.Set("Traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+
+	var server sdktrace.ReadOnlySpan
+	names := make(map[string]bool)
+	for _, span := range recorder.Ended() {
+		// every span continues the caller's trace
+		require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+		names[span.Name()] = true
+		if span.Name() == "POST /files/create" {
+			server = span
+		}
+	}
+	require.NotNil(t, server)
+	require.Equal(t, "00f067aa0ba902b7", server.Parent().SpanID().String())
+	require.True(t, names["wire.Reader.read"])
+	require.True(t, names["wire.FEDWireMessage.verify"])
+
+	attrs := attribute.NewSet(server.Attributes()...)
+	bfc, _ := attrs.Value(wire.AttributeBusinessFunctionCode)
+	require.Equal(t, "CTR", bfc.AsString())
+	status, _ := attrs.Value("http.status_code")
+	require.Equal(t, int64(http.StatusCreated), status.AsInt64())
+}
+
+func TestNewTracerProviderFromEnv(t *testing.T) {
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+
+	t.Setenv("WIRE_TRACING_EXPORTER", "")
+	tp, err := newTracerProviderFromEnv(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, tp)
+
+	t.Setenv("WIRE_TRACING_EXPORTER", "stdout")
+	tp, err = newTracerProviderFromEnv(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, tp)
+	require.Equal(t, tp, otel.GetTracerProvider())
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	t.Setenv("WIRE_TRACING_EXPORTER", "zipkin")
+	_, err = newTracerProviderFromEnv(context.Background())
+	require.ErrorContains(t, err, "unknown WIRE_TRACING_EXPORTER")
+}
@@ -0,0 +1,560 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+// fileStatus is the position of a stored file in the maker-checker approval lifecycle.
+//
+//	draft -> submitted -> approved -> released
+//	                   \-> rejected -> submitted
+type fileStatus string
+
+const (
+	statusDraft     fileStatus = "draft"
+	statusSubmitted fileStatus = "submitted"
+	statusApproved  fileStatus = "approved"
+	statusRejected  fileStatus = "rejected"
+	statusReleased  fileStatus = "released"
+)
+
+var (
+	errWorkflowNoUser           = errors.New("missing X-User-ID header")
+	errWorkflowTransition       = errors.New("invalid workflow transition")
+	errWorkflowSelfApproval     = errors.New("approver cannot be the creator or submitter of the file")
+	errWorkflowNoCreator        = errors.New("file has no recorded creator and cannot be approved")
+	errWorkflowDuplicateApprove = errors.New("approver has already approved this file")
+	errWorkflowNotApproved      = errors.New("file has not been approved")
+	errWorkflowLocked           = errors.New("file cannot be modified once submitted")
+)
+
+// approval records a single checker's sign-off on a file
+type approval struct {
+	ApprovedBy string    `json:"approvedBy"`
+	ApprovedAt time.Time `json:"approvedAt"`
+	Comment    string    `json:"comment,omitempty"`
+}
+
+// fileWorkflow holds the approval state of a stored wire.File
+type fileWorkflow struct {
+	FileID            string     `json:"fileID"`
+	Status            fileStatus `json:"status"`
+	CreatedBy         string     `json:"createdBy"`
+	SubmittedBy       string     `json:"submittedBy,omitempty"`
+	RequiredApprovals int        `json:"requiredApprovals"`
+	Approvals         []approval `json:"approvals"`
+	RejectedBy        string     `json:"rejectedBy,omitempty"`
+	RejectionReason   string     `json:"rejectionReason,omitempty"`
+	ReleasedBy        string     `json:"releasedBy,omitempty"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
+
+// renderable returns true if the file may be rendered for transmission
+func (wf *fileWorkflow) renderable() bool {
+	return wf != nil && (wf.Status == statusApproved || wf.Status == statusReleased)
+}
+
+func (wf *fileWorkflow) transition(from []fileStatus, to fileStatus) error {
+	for i := range from {
+		if wf.Status == from[i] {
+			wf.Status = to
+			wf.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s to %s", errWorkflowTransition, wf.Status, to)
+}
+
+// approvalThreshold requires Approvals sign-offs for files whose amount is at least Amount (in cents)
+type approvalThreshold struct {
+	Amount    int64
+	Approvals int
+}
+
+// approvalWorkflow enforces the maker-checker lifecycle on stored files.
+// A nil *approvalWorkflow means the feature is disabled.
+type approvalWorkflow struct {
+	thresholds []approvalThreshold
+	repo       workflowRepository
+
+	// mu guards locks, which serialize changes to the workflow of each file
+	mu    sync.Mutex
+	locks map[string]*fileLock
+}
+
+// fileLock is held while the workflow of a file is read, checked and saved
+type fileLock struct {
+	sync.Mutex
+	holders int
+}
+
+// lock serializes changes to fileID, so a transition or edit is checked against the state it replaces
+// rather than one read before another request saved over it. The returned func releases the lock.
+func (wf *approvalWorkflow) lock(tenantID, fileID string) func() {
+	if wf == nil {
+		return func() {}
+	}
+	key := tenantID + "/" + fileID
+
+	wf.mu.Lock()
+	if wf.locks == nil {
+		wf.locks = make(map[string]*fileLock)
+	}
+	l, ok := wf.locks[key]
+	if !ok {
+		l = &fileLock{}
+		wf.locks[key] = l
+	}
+	l.holders++
+	wf.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		wf.mu.Lock()
+		if l.holders--; l.holders == 0 {
+			delete(wf.locks, key)
+		}
+		wf.mu.Unlock()
+	}
+}
+
+// newApprovalWorkflowFromEnv reads WIRE_APPROVAL_WORKFLOW and WIRE_APPROVAL_THRESHOLDS.
+// A nil workflow is returned when the feature is not enabled.
+func newApprovalWorkflowFromEnv() (*approvalWorkflow, error) {
+	if enabled, _ := strconv.ParseBool(os.Getenv("WIRE_APPROVAL_WORKFLOW")); !enabled {
+		return nil, nil
+	}
+	thresholds, err := parseApprovalThresholds(os.Getenv("WIRE_APPROVAL_THRESHOLDS"))
+	if err != nil {
+		return nil, err
+	}
+	return &approvalWorkflow{
+		thresholds: thresholds,
+		repo:       newMemoryWorkflowRepository(),
+	}, nil
+}
+
+// parseApprovalThresholds reads a comma separated list of dollar amounts and approval counts.
+// For example: "1000000:2,10000000.50:3"
+func parseApprovalThresholds(raw string) ([]approvalThreshold, error) {
+	var out []approvalThreshold
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		amount, approvals, found := strings.Cut(pair, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid approval threshold %q", pair)
+		}
+		cents, err := parseDollarsToCents(amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid approval threshold amount %q: %v", amount, err)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(approvals))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid approval threshold count %q", approvals)
+		}
+		out = append(out, approvalThreshold{Amount: cents, Approvals: n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Amount < out[j].Amount })
+	return out, nil
+}
+
+func parseDollarsToCents(s string) (int64, error) {
+	dollars, cents, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if len(cents) > 2 {
+		return 0, errors.New("too many decimal places")
+	}
+	cents = (cents + "00")[:2]
+	return strconv.ParseInt(dollars+cents, 10, 64)
+}
+
+// requiredApprovals returns how many distinct checkers must approve file
+func (wf *approvalWorkflow) requiredApprovals(file *wire.File) int {
+	required := 1
+	if file == nil || file.FEDWireMessage.Amount == nil {
+		return required
+	}
+	amount, err := strconv.ParseInt(file.FEDWireMessage.Amount.Amount, 10, 64)
+	if err != nil {
+		return required
+	}
+	for _, t := range wf.thresholds {
+		if amount >= t.Amount && t.Approvals > required {
+			required = t.Approvals
+		}
+	}
+	return required
+}
+
+// lookup returns the workflow for fileID. Files stored before the workflow was tracked are drafts
+// without a creator, so they can't be approved.
+func (wf *approvalWorkflow) lookup(tenantID, fileID string) (*fileWorkflow, error) {
+	state, err := wf.repo.getWorkflow(tenantID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = &fileWorkflow{
+			FileID: fileID,
+			Status: statusDraft,
+		}
+	}
+	return state, nil
+}
+
+// canCreate returns an error unless userID may create a file. Every file needs a creator so it can't be
+// approved by the same user.
+func (wf *approvalWorkflow) canCreate(userID string) error {
+	if wf == nil {
+		return nil
+	}
+	if userID == "" {
+		return errWorkflowNoUser
+	}
+	return nil
+}
+
+// created records a new draft for fileID owned by userID
+func (wf *approvalWorkflow) created(tenantID, fileID, userID string) error {
+	if wf == nil {
+		return nil
+	}
+	return wf.repo.saveWorkflow(tenantID, &fileWorkflow{
+		FileID:    fileID,
+		Status:    statusDraft,
+		CreatedBy: userID,
+		UpdatedAt: time.Now(),
+	})
+}
+
+// modified returns an error if fileID can no longer be edited. Callers hold lock until the edit is saved.
+func (wf *approvalWorkflow) modified(tenantID, fileID string) error {
+	if wf == nil {
+		return nil
+	}
+	state, err := wf.lookup(tenantID, fileID)
+	if err != nil {
+		return err
+	}
+	switch state.Status {
+	case statusDraft:
+		return nil
+	case statusRejected:
+		// editing a rejected file sends it back to draft for a fresh round of approvals
+		return wf.save(tenantID, state, state.transition([]fileStatus{statusRejected}, statusDraft))
+	}
+	return errWorkflowLocked
+}
+
+// canRender returns an error if fileID has not been approved for transmission
+func (wf *approvalWorkflow) canRender(tenantID, fileID string) error {
+	if wf == nil {
+		return nil
+	}
+	state, err := wf.lookup(tenantID, fileID)
+	if err != nil {
+		return err
+	}
+	if !state.renderable() {
+		return errWorkflowNotApproved
+	}
+	return nil
+}
+
+func (wf *approvalWorkflow) deleted(tenantID, fileID string) error {
+	if wf == nil {
+		return nil
+	}
+	return wf.repo.deleteWorkflow(tenantID, fileID)
+}
+
+func (wf *approvalWorkflow) save(tenantID string, state *fileWorkflow, err error) error {
+	if err != nil {
+		return err
+	}
+	return wf.repo.saveWorkflow(tenantID, state)
+}
+
+func (wf *approvalWorkflow) submit(state *fileWorkflow, file *wire.File, userID string) error {
+	if err := file.Validate(); err != nil {
+		return err
+	}
+	if err := state.transition([]fileStatus{statusDraft, statusRejected}, statusSubmitted); err != nil {
+		return err
+	}
+	state.SubmittedBy = userID
+	state.RequiredApprovals = wf.requiredApprovals(file)
+	state.Approvals = nil
+	state.RejectedBy, state.RejectionReason = "", ""
+	return nil
+}
+
+func (wf *approvalWorkflow) approve(state *fileWorkflow, userID, comment string) error {
+	if state.Status != statusSubmitted {
+		return fmt.Errorf("%w: %s to %s", errWorkflowTransition, state.Status, statusApproved)
+	}
+	if state.CreatedBy == "" {
+		// stored before the workflow was enabled, or by an unknown user, so self-approval can't be ruled out
+		return errWorkflowNoCreator
+	}
+	if userID == state.CreatedBy || userID == state.SubmittedBy {
+		return errWorkflowSelfApproval
+	}
+	for i := range state.Approvals {
+		if state.Approvals[i].ApprovedBy == userID {
+			return errWorkflowDuplicateApprove
+		}
+	}
+	state.Approvals = append(state.Approvals, approval{
+		ApprovedBy: userID,
+		ApprovedAt: time.Now(),
+		Comment:    comment,
+	})
+	state.UpdatedAt = time.Now()
+	if len(state.Approvals) >= state.RequiredApprovals {
+		return state.transition([]fileStatus{statusSubmitted}, statusApproved)
+	}
+	return nil
+}
+
+func (wf *approvalWorkflow) reject(state *fileWorkflow, userID, reason string) error {
+	if err := state.transition([]fileStatus{statusSubmitted}, statusRejected); err != nil {
+		return err
+	}
+	state.RejectedBy = userID
+	state.RejectionReason = reason
+	return nil
+}
+
+func (wf *approvalWorkflow) release(state *fileWorkflow, userID string) error {
+	if err := state.transition([]fileStatus{statusApproved}, statusReleased); err != nil {
+		return err
+	}
+	state.ReleasedBy = userID
+	return nil
+}
+
+// workflowProblem writes err with a status code matching the workflow or storage failure
+func workflowProblem(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	switch {
+	case errors.Is(err, errWorkflowSelfApproval), errors.Is(err, errWorkflowDuplicateApprove),
+		errors.Is(err, errWorkflowNotApproved), errors.Is(err, errWorkflowNoCreator):
+		status = http.StatusForbidden
+	case errors.Is(err, errWorkflowTransition), errors.Is(err, errWorkflowLocked), errors.Is(err, errFileExists):
+		status = http.StatusConflict
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
+
+func addWorkflowRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher) {
+	r.Methods("GET").Path("/files/{fileId}/workflow").HandlerFunc(requireRole(roleRead, getFileWorkflow(logger, repo, workflow)))
+	r.Methods("POST").Path("/files/{fileId}/submit").HandlerFunc(requireRole(roleWrite, transitionFile(logger, repo, workflow, events, statusSubmitted)))
+	r.Methods("POST").Path("/files/{fileId}/approve").HandlerFunc(requireRole(roleApprove, transitionFile(logger, repo, workflow, events, statusApproved)))
+	r.Methods("POST").Path("/files/{fileId}/reject").HandlerFunc(requireRole(roleApprove, transitionFile(logger, repo, workflow, events, statusRejected)))
+	r.Methods("POST").Path("/files/{fileId}/release").HandlerFunc(requireRole(roleWrite, transitionFile(logger, repo, workflow, events, statusReleased)))
+}
+
+func getFileWorkflow(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		fileId := getFileId(w, r)
+		if fileId == "" {
+			logger.LogError(errNoFileId)
+			return
+		}
+		logger = logger.Set("fileID", log.String(fileId))
+
+		file, err := repo.getFile(getTenantID(r), fileId)
+		if err != nil {
+			err = logger.LogErrorf("error retrieving file: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if file == nil {
+			logger.Log("file not found")
+			http.NotFound(w, r)
+			return
+		}
+
+		state, err := workflow.lookup(getTenantID(r), fileId)
+		if err != nil {
+			err = logger.LogErrorf("error retrieving workflow: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(state)
+	}
+}
+
+func transitionFile(logger log.Logger, repo WireFileRepository, workflow *approvalWorkflow, events eventPublisher, to fileStatus) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := logger // fields are per request, and requests for one file run concurrently
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		fileId := getFileId(w, r)
+		if fileId == "" {
+			logger.LogError(errNoFileId)
+			return
+		}
+		logger = logger.Set("fileID", log.String(fileId))
+
+		userID := getUserID(r)
+		if userID == "" {
+			logger.LogError(errWorkflowNoUser)
+			moovhttp.Problem(w, errWorkflowNoUser)
+			return
+		}
+		logger = logger.Set("userID", log.String(userID))
+
+		var req struct {
+			Comment string `json:"comment"`
+			Reason  string `json:"reason"`
+		}
+		if r.Body != nil && r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				err = logger.LogErrorf("error reading request body: %v", err).Err()
+				moovhttp.Problem(w, err)
+				return
+			}
+		}
+
+		unlock := workflow.lock(getTenantID(r), fileId)
+		defer unlock()
+
+		file, err := repo.getFile(getTenantID(r), fileId)
+		if err != nil {
+			err = logger.LogErrorf("error retrieving file: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if file == nil {
+			logger.Log("file not found")
+			http.NotFound(w, r)
+			return
+		}
+
+		state, err := workflow.lookup(getTenantID(r), fileId)
+		if err != nil {
+			err = logger.LogErrorf("error retrieving workflow: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		switch to {
+		case statusSubmitted:
+			err = workflow.submit(state, file, userID)
+		case statusApproved:
+			err = workflow.approve(state, userID, req.Comment)
+		case statusRejected:
+			err = workflow.reject(state, userID, req.Reason)
+		case statusReleased:
+			err = workflow.release(state, userID)
+		}
+		if err := workflow.save(getTenantID(r), state, err); err != nil {
+			logger.LogErrorf("problem moving file to %s: %v", to, wire.RedactError(err, wire.DefaultRedactionPolicy))
+			workflowProblem(w, err)
+			return
+		}
+		logger.Logf("file is %s", state.Status)
+
+		if state.Status == to {
+			event := newFileEvent(workflowEventType(state.Status), getTenantID(r), fileId, file)
+			event.Status = state.Status
+			publishFileEvent(r.Context(), logger, events, event)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(state)
+	}
+}
+
+type workflowRepository interface {
+	getWorkflow(tenantID, fileId string) (*fileWorkflow, error)
+	saveWorkflow(tenantID string, state *fileWorkflow) error
+	deleteWorkflow(tenantID, fileId string) error
+}
+
+type memoryWorkflowRepository struct {
+	mu     sync.Mutex
+	states map[string]map[string]*fileWorkflow
+}
+
+func newMemoryWorkflowRepository() *memoryWorkflowRepository {
+	return &memoryWorkflowRepository{
+		states: make(map[string]map[string]*fileWorkflow),
+	}
+}
+
+func (r *memoryWorkflowRepository) getWorkflow(tenantID, fileId string) (*fileWorkflow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if state, ok := r.states[tenantID][fileId]; ok {
+		out := *state
+		out.Approvals = append([]approval(nil), state.Approvals...)
+		return &out, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryWorkflowRepository) saveWorkflow(tenantID string, state *fileWorkflow) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if state == nil || state.FileID == "" {
+		return errors.New("empty Wire File ID")
+	}
+	if r.states[tenantID] == nil {
+		r.states[tenantID] = make(map[string]*fileWorkflow)
+	}
+	r.states[tenantID][state.FileID] = state
+	return nil
+}
+
+func (r *memoryWorkflowRepository) deleteWorkflow(tenantID, fileId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.states[tenantID], fileId)
+	return nil
+}
@@ -0,0 +1,329 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func routerTransition(t *testing.T, router *mux.Router, id, transition, userID string) (*httptest.ResponseRecorder, *fileWorkflow) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/files/"+id+"/"+transition, nil)
+	if userID != "" {
+		req.Header.Set("X-User-ID", userID)
+	}
+
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	var state *fileWorkflow
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&state))
+	}
+	return w, state
+}
+
+func TestParseApprovalThresholds(t *testing.T) {
+	thresholds, err := parseApprovalThresholds("10000000.50:3, 1000000:2")
+	require.NoError(t, err)
+	require.Equal(t, []approvalThreshold{
+		{Amount: 100000000, Approvals: 2},
+		{Amount: 1000000050, Approvals: 3},
+	}, thresholds)
+
+	thresholds, err = parseApprovalThresholds("")
+	require.NoError(t, err)
+	require.Empty(t, thresholds)
+
+	_, err = parseApprovalThresholds("1000")
+	require.Error(t, err)
+
+	_, err = parseApprovalThresholds("1000.001:2")
+	require.Error(t, err)
+
+	_, err = parseApprovalThresholds("1000:0")
+	require.Error(t, err)
+}
+
+func TestApprovalWorkflow_requiredApprovals(t *testing.T) {
+	workflow := &approvalWorkflow{
+		thresholds: []approvalThreshold{
+			{Amount: 100000, Approvals: 2},
+			{Amount: 1000000, Approvals: 3},
+		},
+	}
+	file := func(amount string) *wire.File {
+		f := wire.NewFile()
+		f.FEDWireMessage.Amount = wire.NewAmount()
+		f.FEDWireMessage.Amount.Amount = amount
+		return f
+	}
+
+	require.Equal(t, 1, workflow.requiredApprovals(nil))
+	require.Equal(t, 1, workflow.requiredApprovals(file("000000099999")))
+	require.Equal(t, 2, workflow.requiredApprovals(file("000000100000")))
+	require.Equal(t, 3, workflow.requiredApprovals(file("000001234567")))
+}
+
+func TestApprovalWorkflow(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	workflow := &approvalWorkflow{
+		thresholds: []approvalThreshold{
+			{Amount: 1000000, Approvals: 2},
+		},
+		repo: newMemoryWorkflowRepository(),
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, workflow, nil)
+
+	file := wire.NewFile()
+	file.ID = base.ID()
+	file.AddFEDWireMessage(mockFEDWireMessage())
+	require.NoError(t, repo.saveFile("", file))
+	require.NoError(t, workflow.created("", file.ID, "maker"))
+
+	// drafts are not rendered
+	resp := routerGetFileContents(t, router, file.ID)
+	require.Equal(t, http.StatusForbidden, resp.Code, resp.Body)
+
+	// approving a draft is not permitted
+	resp, _ = routerTransition(t, router, file.ID, "approve", "checker")
+	require.Equal(t, http.StatusConflict, resp.Code, resp.Body)
+
+	// a user is required
+	resp, _ = routerTransition(t, router, file.ID, "submit", "")
+	require.Equal(t, http.StatusBadRequest, resp.Code, resp.Body)
+
+	resp, state := routerTransition(t, router, file.ID, "submit", "maker")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	require.Equal(t, statusSubmitted, state.Status)
+	require.Equal(t, 2, state.RequiredApprovals)
+
+	// submitted files are locked
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/files/"+file.ID+"/FEDWireMessage", strings.NewReader(`{}`))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusConflict, w.Code, w.Body)
+
+	// the maker cannot approve their own file
+	resp, _ = routerTransition(t, router, file.ID, "approve", "maker")
+	require.Equal(t, http.StatusForbidden, resp.Code, resp.Body)
+
+	resp, state = routerTransition(t, router, file.ID, "approve", "checker")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	require.Equal(t, statusSubmitted, state.Status)
+	require.Len(t, state.Approvals, 1)
+
+	// each approval must come from a different checker
+	resp, _ = routerTransition(t, router, file.ID, "approve", "checker")
+	require.Equal(t, http.StatusForbidden, resp.Code, resp.Body)
+
+	resp = routerGetFileContents(t, router, file.ID)
+	require.Equal(t, http.StatusForbidden, resp.Code, resp.Body)
+
+	resp, state = routerTransition(t, router, file.ID, "approve", "supervisor")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	require.Equal(t, statusApproved, state.Status)
+
+	resp = routerGetFileContents(t, router, file.ID)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+
+	resp, state = routerTransition(t, router, file.ID, "release", "operator")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	require.Equal(t, statusReleased, state.Status)
+	require.Equal(t, "operator", state.ReleasedBy)
+
+	// released files are final
+	resp, _ = routerTransition(t, router, file.ID, "reject", "checker")
+	require.Equal(t, http.StatusConflict, resp.Code, resp.Body)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "/files/"+file.ID+"/workflow", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&state))
+	require.Equal(t, statusReleased, state.Status)
+	require.Equal(t, "maker", state.CreatedBy)
+}
+
+func TestApprovalWorkflow_reject(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	workflow := &approvalWorkflow{
+		repo: newMemoryWorkflowRepository(),
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, workflow, nil)
+
+	// created through the API so the creator is recorded
+	file := wire.NewFile()
+	file.AddFEDWireMessage(mockFEDWireMessage())
+	bs, err := json.Marshal(file)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/files/create", strings.NewReader(string(bs)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-User-ID", "maker")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&file))
+
+	// files can't be created anonymously
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/files/create", strings.NewReader(string(bs)))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), errWorkflowNoUser.Error())
+
+	resp, _ := routerTransition(t, router, file.ID, "submit", "maker")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+
+	resp, state := routerTransition(t, router, file.ID, "reject", "checker")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	require.Equal(t, statusRejected, state.Status)
+	require.Equal(t, "checker", state.RejectedBy)
+
+	resp, _ = routerTransition(t, router, file.ID, "release", "operator")
+	require.Equal(t, http.StatusConflict, resp.Code, resp.Body)
+
+	resp = routerGetFileContents(t, router, file.ID)
+	require.Equal(t, http.StatusForbidden, resp.Code, resp.Body)
+
+	// resubmitting clears the rejection
+	resp, state = routerTransition(t, router, file.ID, "submit", "maker")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	require.Equal(t, statusSubmitted, state.Status)
+	require.Empty(t, state.RejectedBy)
+	require.Equal(t, 1, state.RequiredApprovals)
+
+	// deleting the file removes its workflow
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("DELETE", "/files/"+file.ID, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+
+	got, err := workflow.repo.getWorkflow("", file.ID)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+// slowWorkflowRepository pauses after each read so concurrent requests read the same workflow
+type slowWorkflowRepository struct {
+	workflowRepository
+}
+
+func (r slowWorkflowRepository) getWorkflow(tenantID, fileId string) (*fileWorkflow, error) {
+	state, err := r.workflowRepository.getWorkflow(tenantID, fileId)
+	time.Sleep(5 * time.Millisecond)
+	return state, err
+}
+
+func TestApprovalWorkflow_concurrentTransitions(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	workflow := &approvalWorkflow{
+		thresholds: []approvalThreshold{
+			{Amount: 1000000, Approvals: 2},
+		},
+		repo: slowWorkflowRepository{newMemoryWorkflowRepository()},
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, workflow, nil)
+
+	for i := 0; i < 10; i++ {
+		file := wire.NewFile()
+		file.ID = base.ID()
+		file.AddFEDWireMessage(mockFEDWireMessage())
+		require.NoError(t, repo.saveFile("", file))
+		require.NoError(t, workflow.created("", file.ID, "maker"))
+
+		resp, _ := routerTransition(t, router, file.ID, "submit", "maker")
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+
+		// approvals and a rejection race for the same submitted file
+		transitions := []struct{ transition, userID string }{
+			{"approve", "checker"}, {"reject", "supervisor"}, {"approve", "auditor"}, {"approve", "manager"},
+		}
+		codes := make([]int, len(transitions))
+		var wg sync.WaitGroup
+		for j := range transitions {
+			wg.Add(1)
+			go func(j int) {
+				defer wg.Done()
+				w := httptest.NewRecorder()
+				req := httptest.NewRequest("POST", "/files/"+file.ID+"/"+transitions[j].transition, nil)
+				req.Header.Set("X-User-ID", transitions[j].userID)
+				router.ServeHTTP(w, req)
+				codes[j] = w.Code
+			}(j)
+		}
+		wg.Wait()
+
+		state, err := workflow.lookup("", file.ID)
+		require.NoError(t, err)
+
+		// every accepted approval is kept, and a file is only rejected when the rejection was accepted
+		approved := 0
+		for j := range transitions {
+			if transitions[j].transition == "approve" && codes[j] == http.StatusOK {
+				approved++
+			}
+		}
+		require.Len(t, state.Approvals, approved, codes)
+		require.Equal(t, codes[1] == http.StatusOK, state.Status == statusRejected, codes)
+		if state.Status != statusRejected {
+			require.Equal(t, statusApproved, state.Status, codes)
+			require.Equal(t, 2, approved, codes)
+		}
+	}
+}
+
+func TestApprovalWorkflow_selfApproval(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	workflow := &approvalWorkflow{
+		repo: newMemoryWorkflowRepository(),
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, workflow, nil)
+
+	// stored before the workflow was enabled, so there's no creator
+	file := wire.NewFile()
+	file.ID = base.ID()
+	file.AddFEDWireMessage(mockFEDWireMessage())
+	require.NoError(t, repo.saveFile("", file))
+
+	resp, _ := routerTransition(t, router, file.ID, "submit", "alice")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	resp, _ = routerTransition(t, router, file.ID, "approve", "alice")
+	require.Equal(t, http.StatusForbidden, resp.Code, resp.Body)
+	resp, _ = routerTransition(t, router, file.ID, "approve", "checker")
+	require.Equal(t, http.StatusForbidden, resp.Code, resp.Body)
+	require.Contains(t, resp.Body.String(), errWorkflowNoCreator.Error())
+
+	// the submitter can't approve a file someone else created
+	require.NoError(t, workflow.created("", file.ID, "maker"))
+	resp, _ = routerTransition(t, router, file.ID, "submit", "alice")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	resp, _ = routerTransition(t, router, file.ID, "approve", "alice")
+	require.Equal(t, http.StatusForbidden, resp.Code, resp.Body)
+	require.Contains(t, resp.Body.String(), errWorkflowSelfApproval.Error())
+
+	resp, state := routerTransition(t, router, file.ID, "approve", "checker")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
+	require.Equal(t, statusApproved, state.Status)
+}
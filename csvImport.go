@@ -0,0 +1,314 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/base"
+	"gopkg.in/yaml.v3"
+)
+
+// bfcPath is the path of the business function code, which selects the template of a row
+const bfcPath = "businessFunctionCode.businessFunctionCode"
+
+// CSVMapping maps the columns of a CSV file, such as a payment run exported from a spreadsheet, to the
+// fields of FEDWireMessage. Fields are named by their path of JSON names, e.g. beneficiary.personal.name
+// or amount.amount, the same paths ValidationIssue.Field reports.
+//
+// The fields of each message are set from Defaults, then the Templates of its business function code,
+// then the non-empty cells of its row.
+type CSVMapping struct {
+	// Columns maps the header of each column read to the path of a field
+	Columns map[string]string `json:"columns"`
+	// Defaults are the values of fields of every message, keyed by path
+	Defaults map[string]string `json:"defaults,omitempty"`
+	// Templates are the values of fields of messages with a business function code, e.g. CTR, keyed by
+	// the code and then by path. The code is taken from the row or Defaults.
+	Templates map[string]map[string]string `json:"templates,omitempty"`
+	// ValidateOptions are set on every message before it's validated
+	ValidateOptions *ValidateOpts `json:"validateOptions,omitempty"`
+}
+
+// ParseCSVMapping reads a CSVMapping from YAML or JSON and checks every path it names is a field of
+// FEDWireMessage.
+func ParseCSVMapping(data []byte) (*CSVMapping, error) {
+	// YAML is decoded like JSON so the mapping has a single set of field names
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("problem reading CSV mapping: %v", err)
+	}
+	bs, err := json.Marshal(yamlValue(&node))
+	if err != nil {
+		return nil, fmt.Errorf("problem reading CSV mapping: %v", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.DisallowUnknownFields()
+	mapping := &CSVMapping{}
+	if err := dec.Decode(mapping); err != nil {
+		return nil, fmt.Errorf("problem reading CSV mapping: %v", err)
+	}
+	if err := mapping.Validate(); err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// yamlValue returns node as the value encoding/json would decode. Scalars other than booleans and
+// null keep their text, so codes such as 00 aren't read as numbers.
+func yamlValue(node *yaml.Node) interface{} {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) > 0 {
+			return yamlValue(node.Content[0])
+		}
+	case yaml.AliasNode:
+		return yamlValue(node.Alias)
+	case yaml.MappingNode:
+		out := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			out[node.Content[i].Value] = yamlValue(node.Content[i+1])
+		}
+		return out
+	case yaml.SequenceNode:
+		out := make([]interface{}, 0, len(node.Content))
+		for _, n := range node.Content {
+			out = append(out, yamlValue(n))
+		}
+		return out
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			return nil
+		case "!!bool":
+			var b bool
+			if err := node.Decode(&b); err == nil {
+				return b
+			}
+		}
+		return node.Value
+	}
+	return nil
+}
+
+// Validate checks the mapping has columns and every path it names is a text field of FEDWireMessage
+func (m *CSVMapping) Validate() error {
+	if m == nil || len(m.Columns) == 0 {
+		return errors.New("CSV mapping has no columns")
+	}
+	var errs base.ErrorList
+	check := func(where string, fields map[string]string) {
+		for _, path := range sortedKeys(fields) {
+			if err := checkFieldPath(path); err != nil {
+				errs.Add(fmt.Errorf("%s: %v", where, err))
+			}
+		}
+	}
+	for _, column := range sortedKeys(m.Columns) {
+		if err := checkFieldPath(m.Columns[column]); err != nil {
+			errs.Add(fmt.Errorf("column %q: %v", column, err))
+		}
+	}
+	check("defaults", m.Defaults)
+	codes := make([]string, 0, len(m.Templates))
+	for code := range m.Templates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		check(fmt.Sprintf("template %s", code), m.Templates[code])
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// checkFieldPath returns an error unless path names a text field of FEDWireMessage
+func checkFieldPath(path string) error {
+	t := reflect.TypeOf(FEDWireMessage{})
+	for _, name := range strings.Split(path, ".") {
+		if t.Kind() != reflect.Struct {
+			return fmt.Errorf("%s is not a field", path)
+		}
+		sf, ok := fieldByJSONName(t, name)
+		if !ok {
+			return fmt.Errorf("%s is not a field: %s has no field %q", path, t.Name(), name)
+		}
+		t = sf.Type
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+	if t.Kind() != reflect.String {
+		return fmt.Errorf("%s is not a text field", path)
+	}
+	return nil
+}
+
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if sf := t.Field(i); sf.IsExported() && sf.Tag.Get("json") != "-" && jsonName(sf) == name {
+			return sf, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// CSVRow is the message created from a row of a CSV file
+type CSVRow struct {
+	// Row is the line of the CSV file the row starts on, with the header as row 1
+	Row int
+	// File holds the message of the row, which is nil when the row couldn't be read
+	File *File
+	// Err is why the row couldn't be read or its message is invalid, as a *CSVRowError
+	Err error
+	// Normalizations are the fields changed when ValidateOptions.NormalizeCharset is set
+	Normalizations []Normalization
+}
+
+// CSVRowError is an error found in a row of a CSV file
+type CSVRowError struct {
+	Row int
+	Err error
+}
+
+func (e *CSVRowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *CSVRowError) Unwrap() error {
+	return e.Err
+}
+
+// ReadCSV creates a message from each row of the CSV file in r, whose first row is the header, with
+// mapping and validates it. Rows which can't be read or are invalid are returned with a *CSVRowError
+// and don't stop the others from being read. An error is returned when the header doesn't have every
+// column of mapping.
+func ReadCSV(r io.Reader, mapping *CSVMapping) ([]CSVRow, error) {
+	if err := mapping.Validate(); err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("problem reading CSV header: %v", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff") // spreadsheets may write a byte order mark
+		}
+		columns[strings.TrimSpace(name)] = i
+	}
+	var missing []string
+	for _, column := range sortedKeys(mapping.Columns) {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("CSV header is missing columns: %s", strings.Join(missing, ", "))
+	}
+
+	var rows []CSVRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			rows = append(rows, CSVRow{Row: pe.StartLine, Err: &CSVRowError{Row: pe.StartLine, Err: pe.Err}})
+			continue
+		}
+		if err != nil {
+			return rows, fmt.Errorf("problem reading CSV: %v", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := CSVRow{Row: line}
+		row.File, row.Normalizations, row.Err = mapping.file(columns, record)
+		if row.Err != nil {
+			row.Err = &CSVRowError{Row: line, Err: row.Err}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// file creates and validates the message of record, whose columns are indexed by header
+func (m *CSVMapping) file(header map[string]int, record []string) (*File, []Normalization, error) {
+	cells := make(map[string]string)
+	for column, path := range m.Columns {
+		if value := strings.TrimSpace(record[header[column]]); value != "" {
+			cells[path] = value
+		}
+	}
+	code, ok := cells[bfcPath]
+	if !ok {
+		code = m.Defaults[bfcPath]
+	}
+
+	fwm := make(map[string]interface{})
+	for _, fields := range []map[string]string{m.Defaults, m.Templates[code], cells} {
+		for path, value := range fields {
+			setFieldPath(fwm, strings.Split(path, "."), value)
+		}
+	}
+
+	// the tags record their tag when they're read from JSON
+	bs, err := json.Marshal(map[string]interface{}{"fedWireMessage": fwm})
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := FileFromJSON(bs)
+	if err != nil {
+		return nil, nil, err
+	}
+	var normalizations []Normalization
+	if m.ValidateOptions != nil {
+		opts := *m.ValidateOptions
+		file.SetValidation(&opts)
+		if opts.NormalizeCharset {
+			normalizations = file.Normalize()
+			if err := NormalizationOverflow(normalizations); err != nil {
+				return file, normalizations, err
+			}
+		}
+	}
+	return file, normalizations, file.Validate()
+}
+
+func setFieldPath(m map[string]interface{}, path []string, value string) {
+	if len(path) == 1 {
+		m[path[0]] = value
+		return
+	}
+	child, ok := m[path[0]].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		m[path[0]] = child
+	}
+	setFieldPath(child, path[1:], value)
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readCSVMapping(t *testing.T) *CSVMapping {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "payments-mapping.yaml"))
+	require.NoError(t, err)
+	mapping, err := ParseCSVMapping(bs)
+	require.NoError(t, err)
+	return mapping
+}
+
+func TestReadCSV(t *testing.T) {
+	mapping := readCSVMapping(t)
+	// numbers keep their text
+	require.Equal(t, "00", mapping.Defaults["typeSubType.subTypeCode"])
+	require.True(t, mapping.ValidateOptions.SkipMandatoryIMAD)
+
+	fd, err := os.Open(filepath.Join("test", "testdata", "payments.csv"))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	rows, err := ReadCSV(fd, mapping)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	for i, row := range rows {
+		require.Equal(t, i+2, row.Row)
+		require.NoError(t, row.Err)
+	}
+
+	fwm := rows[1].File.FEDWireMessage
+	require.Equal(t, "PAY-0002", fwm.SenderReference.SenderReference)
+	require.Equal(t, "Doe, John", fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "121042882", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "Acme Treasury", fwm.Originator.Personal.Name)
+	require.Nil(t, fwm.BeneficiaryFI)
+
+	// the tags were set, so the message can be written
+	require.Contains(t, fwm.Amount.String(), TagAmount)
+
+	// BTR has its own template
+	fwm = rows[2].File.FEDWireMessage
+	require.Equal(t, BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "Citadel", fwm.BeneficiaryFI.FinancialInstitution.Name)
+	require.Nil(t, fwm.Originator)
+}
+
+func TestReadCSV_rowErrors(t *testing.T) {
+	mapping := readCSVMapping(t)
+
+	csv := strings.Join([]string{
+		"\ufeffType,Reference,Amount,Receiver ABA,Receiver,Beneficiary Account,Beneficiary,Beneficiary Address",
+		"CTR,PAY-0001,000001234567,231380104,Citadel,123456789,Jane Doe,2 Elm Street",
+		"CTR,PAY-0002,12.50,231380104,Citadel,123456789,Jane Doe,2 Elm Street",
+		"CTR,PAY-0003,000001234567",
+		"CTR,PAY-0004,000001234567,231380104,Citadel,123456789,Jane Doe,2 Elm Street",
+	}, "\n")
+	rows, err := ReadCSV(strings.NewReader(csv), mapping)
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+
+	require.NoError(t, rows[0].Err)
+	require.NoError(t, rows[3].Err)
+	require.Equal(t, 5, rows[3].Row)
+
+	var rowErr *CSVRowError
+	require.True(t, errors.As(rows[1].Err, &rowErr))
+	require.Equal(t, 3, rowErr.Row)
+	require.True(t, strings.HasPrefix(rows[1].Err.Error(), "row 3: "))
+	require.Equal(t, "/fedWireMessage/amount/amount", rows[1].File.FEDWireMessage.IssueFor(rows[1].Err).Pointer)
+
+	require.Equal(t, 4, rows[2].Row)
+	require.Nil(t, rows[2].File)
+	require.Contains(t, rows[2].Err.Error(), "row 4: wrong number of fields")
+}
+
+func TestReadCSV_missingColumns(t *testing.T) {
+	_, err := ReadCSV(strings.NewReader("Type,Amount\nCTR,000001234567\n"), readCSVMapping(t))
+	require.EqualError(t, err, "CSV header is missing columns: Beneficiary, Beneficiary Account, Beneficiary Address, Receiver, Receiver ABA, Reference")
+}
+
+func TestParseCSVMapping(t *testing.T) {
+	mapping, err := ParseCSVMapping([]byte(`{"columns": {"Name": "beneficiary.personal.name"}, "defaults": {"amount.amount": "000000000100"}}`))
+	require.NoError(t, err)
+	require.Equal(t, "beneficiary.personal.name", mapping.Columns["Name"])
+
+	_, err = ParseCSVMapping([]byte(`columns: {}`))
+	require.EqualError(t, err, "CSV mapping has no columns")
+
+	_, err = ParseCSVMapping([]byte(`colums: {Name: beneficiary.personal.name}`))
+	require.ErrorContains(t, err, `unknown field "colums"`)
+
+	_, err = ParseCSVMapping([]byte(`
+columns:
+  Name: beneficiary.personal.nmae
+  Beneficiary: beneficiary.personal
+templates:
+  CTR:
+    amount: "1"
+`))
+	list, ok := err.(base.ErrorList)
+	require.True(t, ok)
+	require.Len(t, list, 3)
+	require.EqualError(t, list[0], `column "Beneficiary": beneficiary.personal is not a text field`)
+	require.EqualError(t, list[1], `column "Name": beneficiary.personal.nmae is not a field: Personal has no field "nmae"`)
+	require.EqualError(t, list[2], `template CTR: amount is not a text field`)
+}
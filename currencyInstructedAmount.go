@@ -6,10 +6,14 @@ package wire
 
 import (
 	"encoding/json"
+	"regexp"
 	"strings"
 	"unicode/utf8"
 )
 
+// currencyPrefixRegex matches the ISO 4217 currency code which may precede a CurrencyInstructedAmount
+var currencyPrefixRegex = regexp.MustCompile(`^[A-Z]{3}`)
+
 // CurrencyInstructedAmount is the currency instructed amount
 type CurrencyInstructedAmount struct {
 	// tag
@@ -18,7 +22,8 @@ type CurrencyInstructedAmount struct {
 	SwiftFieldTag string `json:"swiftFieldTag"`
 	// Amount is the instructed amount
 	// Amount Must begin with at least one numeric character (0-9) and contain only one decimal comma marker
-	// (e.g., $1,234.56 should be entered as 1234,56 and $0.99 should be entered as
+	// (e.g., $1,234.56 should be entered as 1234,56 and $0.99 should be entered as 0,99). It may be preceded
+	// by the three letter currency code of SWIFT field 33B (e.g., EUR1234,56).
 	Amount string `json:"amount"`
 	// validator is composed for data validation
 	validator
@@ -109,7 +114,8 @@ func (cia *CurrencyInstructedAmount) Validate() error {
 	if err := cia.isAlphanumeric(cia.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, cia.SwiftFieldTag)
 	}
-	if err := cia.isAmount(cia.Amount); err != nil {
+	// Amount may begin with the currency code of SWIFT field 33B
+	if err := cia.isAmount(currencyPrefixRegex.ReplaceAllString(cia.Amount, "")); err != nil {
 		return fieldError("Amount", err, cia.Amount)
 	}
 	return nil
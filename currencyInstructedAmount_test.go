@@ -42,6 +42,23 @@ func TestCurrencyInstructedAmountValid(t *testing.T) {
 	require.EqualError(t, err, fieldError("Amount", ErrNonAmount, cia.Amount).Error())
 }
 
+// TestCurrencyInstructedAmountCurrency validates CurrencyInstructedAmount Amount may carry the currency of field 33B
+func TestCurrencyInstructedAmountCurrency(t *testing.T) {
+	cia := mockCurrencyInstructedAmount()
+	cia.Amount = "EUR1130,00"
+
+	require.NoError(t, cia.Validate())
+
+	cia.Amount = "EUR11A0,00"
+	require.EqualError(t, cia.Validate(), fieldError("Amount", ErrNonAmount, cia.Amount).Error())
+
+	// only a three letter currency code may precede the amount
+	for _, amount := range []string{"ABCDEFG1,00", "EU1130,00", "eur1130,00"} {
+		cia.Amount = amount
+		require.EqualError(t, cia.Validate(), fieldError("Amount", ErrNonAmount, cia.Amount).Error(), amount)
+	}
+}
+
 // TestParseCurrencyInstructedAmountWrongLength parses a wrong CurrencyInstructedAmount record length
 func TestParseCurrencyInstructedAmountWrongLength(t *testing.T) {
 	var line = "{7033}Swift*000000000001500,4"
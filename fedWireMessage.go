@@ -143,6 +143,10 @@ func (fwm *FEDWireMessage) requireSenderSupplied() bool {
 	return !opts.AllowMissingSenderSupplied
 }
 
+func (fwm *FEDWireMessage) requireIMAD() bool {
+	return fwm == nil || fwm.ValidateOptions == nil || !fwm.ValidateOptions.SkipMandatoryIMAD
+}
+
 // verify checks basic WIRE rules. Assumes properly parsed records. Each validation func should
 // check for the expected relationships between fields within a FedWireMessage.
 func (fwm *FEDWireMessage) verify() error {
@@ -151,57 +155,36 @@ func (fwm *FEDWireMessage) verify() error {
 		return err
 	}
 
-	if err := fwm.otherTransferInformation(); err != nil {
-		return err
-	}
-	if err := fwm.validateBeneficiaryIntermediaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateBeneficiaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateOriginatorFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateInstructingFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateOriginatorToBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIIntermediaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIIntermediaryFIAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiaryFIAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiaryAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIPaymentMethodToBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateUnstructuredAddenda(); err != nil {
-		return err
-	}
-
-	if err := fwm.validateRelatedRemittance(); err != nil {
-		return err
+	for _, validate := range fwm.validations() {
+		if err := validate(); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	if err := fwm.isRemittanceValid(); err != nil {
-		return err
+// validations returns the checks verify runs once the mandatory fields are present. Each check is
+// independent of the others, so all of them can run to collect every error.
+func (fwm *FEDWireMessage) validations() []func() error {
+	return []func() error{
+		fwm.otherTransferInformation,
+		fwm.validateBeneficiaryIntermediaryFI,
+		fwm.validateBeneficiaryFI,
+		fwm.validateOriginatorFI,
+		fwm.validateInstructingFI,
+		fwm.validateOriginatorToBeneficiary,
+		fwm.validateFIIntermediaryFI,
+		fwm.validateFIIntermediaryFIAdvice,
+		fwm.validateFIBeneficiaryFI,
+		fwm.validateFIBeneficiaryFIAdvice,
+		fwm.validateFIBeneficiary,
+		fwm.validateFIBeneficiaryAdvice,
+		fwm.validateFIPaymentMethodToBeneficiary,
+		fwm.validateUnstructuredAddenda,
+		fwm.validateRelatedRemittance,
+		fwm.isRemittanceValid,
+		fwm.reconcileRemittance,
 	}
-	return nil
 }
 
 // mandatoryFields validates mandatory tags for a FEDWireMessage are defined
@@ -230,7 +213,7 @@ func (fwm *FEDWireMessage) mandatoryFields() error {
 		return err
 	}
 
-	if fwm.ValidateOptions == nil || !fwm.ValidateOptions.SkipMandatoryIMAD {
+	if fwm.requireIMAD() {
 		if err := fwm.validateIMAD(); err != nil {
 			return err
 		}
@@ -1254,7 +1237,10 @@ func (fwm *FEDWireMessage) validateUnstructuredAddenda() error {
 			if fwm.UnstructuredAddenda == nil {
 				return fieldError("UnstructuredAddenda", ErrFieldRequired)
 			}
-			return fwm.UnstructuredAddenda.Validate()
+			if err := fwm.UnstructuredAddenda.Validate(); err != nil {
+				return err
+			}
+			return fwm.UnstructuredAddenda.validateCharset(fwm.LocalInstrument.LocalInstrumentCode)
 		default:
 			if fwm.UnstructuredAddenda != nil {
 				return NewErrInvalidPropertyForProperty("UnstructuredAddenda", fwm.UnstructuredAddenda.String(),
@@ -1267,9 +1253,6 @@ func (fwm *FEDWireMessage) validateUnstructuredAddenda() error {
 		}
 	}
 
-	// TODO: if LocalInstrument is ANSIX12format or STP820format, make sure Addenda Information only contains charaters within the X12 character set
-	// TODO: if LocalInstrument is any of the other permitted formats, make sure Addenda Information only contains charaters within the SWIFT MX ISO 20022 character set
-
 	return nil
 }
 
@@ -397,6 +397,23 @@ func TestFEDWireMessage_validateUnstructuredAddenda(t *testing.T) {
 	require.EqualError(t, err, expected)
 }
 
+func TestFEDWireMessage_validateUnstructuredAddendaCharset(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.LocalInstrument = mockLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = ANSIX12format
+	fwm.UnstructuredAddenda = NewUnstructuredAddenda()
+	fwm.UnstructuredAddenda.Addenda = "ST*820*0001~BPR*C*10.00*C*FWT~SE*3*0001~"
+	require.NoError(t, fwm.validateUnstructuredAddenda())
+
+	// the grave accent is outside the X12 character set but within SWIFT MX
+	fwm.UnstructuredAddenda.Addenda = "RMR*IV*`1~"
+	require.EqualError(t, fwm.validateUnstructuredAddenda(), fieldError("Addenda", ErrAddendaCharset, "RMR*IV*`1~").Error())
+
+	fwm.LocalInstrument.LocalInstrumentCode = NarrativeText
+	require.NoError(t, fwm.validateUnstructuredAddenda())
+}
+
 func TestFEDWireMessage_validateRelatedRemittance(t *testing.T) {
 	fwm := mockCustomerTransferData()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
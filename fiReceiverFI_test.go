@@ -170,3 +170,15 @@ func TestStringFIReceiverFIOptions(t *testing.T) {
 	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{6100}*")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestFIReceiverFIText validates FIToFI text wraps across lines of 30 and 33 characters
+func TestFIReceiverFIText(t *testing.T) {
+	firfi := mockFIReceiverFI()
+	require.NoError(t, firfi.FIToFI.SetText("Please credit the beneficiary account on receipt and advise by phone"))
+	require.Equal(t, "Please credit the beneficiary", firfi.FIToFI.LineOne)
+	require.Equal(t, "account on receipt and advise by", firfi.FIToFI.LineTwo)
+	require.Equal(t, "phone", firfi.FIToFI.LineThree)
+	require.Empty(t, firfi.FIToFI.LineFour)
+	require.Equal(t, "Please credit the beneficiary account on receipt and advise by phone", firfi.FIToFI.Text())
+	require.NoError(t, firfi.Validate())
+}
@@ -19,3 +19,20 @@ type FIToFI struct {
 	// LineSix
 	LineSix string `json:"lineSix,omitempty"`
 }
+
+// SetText word-wraps text across the lines of FIToFI, which hold 30 characters on LineOne and 33 on the
+// others. FIToFI is left unchanged when text doesn't fit or has characters outside the wire character set.
+func (fi *FIToFI) SetText(text string) error {
+	lines, err := WrapText(text, 30, 33, 33, 33, 33, 33)
+	if err != nil {
+		return err
+	}
+	fi.LineOne, fi.LineTwo, fi.LineThree = lines[0], lines[1], lines[2]
+	fi.LineFour, fi.LineFive, fi.LineSix = lines[3], lines[4], lines[5]
+	return nil
+}
+
+// Text joins the lines of FIToFI into a single paragraph
+func (fi *FIToFI) Text() string {
+	return JoinText(fi.LineOne, fi.LineTwo, fi.LineThree, fi.LineFour, fi.LineFive, fi.LineSix)
+}
@@ -22,6 +22,10 @@ var (
 	ErrNonAmount = errors.New("is an incorrect amount format")
 	// ErrNonCurrencyCode is returned for an incorrect currency code
 	ErrNonCurrencyCode = errors.New("is not a recognized currency code")
+	// ErrNonCountryCode is returned for an incorrect ISO 3166 country code
+	ErrNonCountryCode = errors.New("is not a recognized country code")
+	// ErrTextOverflow is returned when text doesn't fit in the lines of a tag
+	ErrTextOverflow = errors.New("does not fit in the lines of the tag")
 	// ErrUpperAlpha is returned when a field is not in uppercase
 	ErrUpperAlpha = errors.New("is not uppercase A-Z or 0-9")
 	// ErrFieldInclusion is returned when a field is mandatory and has a default value
@@ -111,6 +115,49 @@ var (
 	// ErrCreditDebitIndicator is returned for an invalid credit or debit indicator
 	ErrCreditDebitIndicator = errors.New("is an invalid credit or debit indicator")
 
+	// ErrRemittanceCurrency is returned when a remittance amount's currency differs from the others
+	ErrRemittanceCurrency = errors.New("does not match the currency of the other remittance amounts")
+
+	// ErrRemittanceNetAmount is returned when ActualAmountPaid isn't the gross amount less discount and adjustment
+	ErrRemittanceNetAmount = errors.New("does not equal the gross amount less discount and adjustment")
+
+	// ErrRemittanceTransferAmount is returned when ActualAmountPaid doesn't match the amount of the transfer
+	ErrRemittanceTransferAmount = errors.New("does not equal the amount of the transfer")
+
+	// ErrAddendaFormat is returned when addenda remittance isn't supported for a local instrument code
+	ErrAddendaFormat = errors.New("is not an X12, EDIFACT or XML remittance format")
+
+	// ErrAddendaRemittance is returned when addenda doesn't hold a remittance advice
+	ErrAddendaRemittance = errors.New("does not contain a remittance advice")
+
+	// ErrAddendaLengthMismatch is returned when AddendaLength doesn't equal the length of Addenda
+	ErrAddendaLengthMismatch = errors.New("does not equal the length of the addenda")
+
+	// ErrAddendaCharset is returned when addenda contains characters its local instrument doesn't permit
+	ErrAddendaCharset = errors.New("contains characters outside the character set of the local instrument")
+
+	// ErrAddendaSeparator is returned when a value written to an X12 remittance contains an element separator
+	// or segment terminator, which X12 can't escape
+	ErrAddendaSeparator = errors.New("contains an X12 element separator or segment terminator")
+
+	// ErrAddendaLength is returned when addenda exceeds the maximum length of 8994 characters
+	ErrAddendaLength = errors.New("exceeds the maximum addenda length of 8994 characters")
+
+	// ErrSwiftMessageType is returned when SWIFT text isn't an MT103 or MT202COV
+	ErrSwiftMessageType = errors.New("is not an MT103 or MT202COV message")
+
+	// ErrSwiftFieldTag is returned when a SWIFT field option isn't permitted in a cover payment tag
+	ErrSwiftFieldTag = errors.New("is not a permitted SWIFT field tag")
+
+	// ErrSwiftField is returned for a malformed SWIFT field
+	ErrSwiftField = errors.New("is a malformed SWIFT field")
+
+	// ErrSwiftFieldLines is returned when a SWIFT field has more lines than its Fedwire tag holds
+	ErrSwiftFieldLines = errors.New("has more lines than the Fedwire tag holds")
+
+	// ErrSwiftCurrency is returned when a SWIFT settlement amount isn't in USD
+	ErrSwiftCurrency = errors.New("is not settled in USD")
+
 	// ErrAdjustmentReasonCode is returned for an invalid adjustment reason code
 	ErrAdjustmentReasonCode = errors.New("is an invalid adjustment reason code")
 
@@ -120,9 +167,18 @@ var (
 	// ErrOptionFLine is returned for an invalid line for OriginatorOptionF
 	ErrOptionFLine = errors.New("is an invalid line for originator optionF")
 
+	// ErrOptionFLineOrder is returned for a line of OriginatorOptionF out of line code order
+	ErrOptionFLineOrder = errors.New("is out of line code order for originator optionF")
+
+	// ErrOptionFLines is returned when OptionF needs more lines than OriginatorOptionF holds
+	ErrOptionFLines = errors.New("needs more than the three lines of originator optionF")
+
 	// ErrOptionFName is returned for an invalid name for OriginatorOptionF
 	ErrOptionFName = errors.New("is an invalid name for originator optionF")
 
+	// ErrNormalizedLength is returned when normalizing a value made it longer than its field
+	ErrNormalizedLength = errors.New("is longer than the field after normalization")
+
 	// ErrValidLength is returned for an field with invalid length
 	ErrValidLength = errors.New("is an invalid length")
 
@@ -6,6 +6,7 @@ package wire
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -59,7 +60,13 @@ func (f *File) Create() error {
 
 // Validate will never modify the file.
 func (f *File) Validate() error {
-	if err := f.FEDWireMessage.verify(); err != nil {
+	return f.ValidateWithContext(context.Background())
+}
+
+// ValidateWithContext validates the file like Validate, recording an OpenTelemetry span as a child of
+// any span in ctx.
+func (f *File) ValidateWithContext(ctx context.Context) error {
+	if err := f.FEDWireMessage.verifyWithContext(ctx); err != nil {
 		return err
 	}
 	return nil
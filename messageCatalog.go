@@ -0,0 +1,51 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MessageCatalog provides the message of a ValidationIssue, letting messages be translated into other
+// languages while codes stay the same
+type MessageCatalog interface {
+	// Message returns the message for issue, or an empty string to keep its current message
+	Message(issue ValidationIssue) string
+}
+
+// Messages is a MessageCatalog of message templates keyed by rule code (W-3600-BFC-INVALID) or, for
+// every field, by rule (INVALID). A template may include {tag}, {field} and {value}, e.g.
+//
+//	"W-3600-BFC-INVALID": "{value} n'est pas un code de fonction commerciale valide"
+//	"REQUIRED":           "{field} est obligatoire"
+type Messages map[string]string
+
+// Message returns the template for the code of issue, or else its rule, with the placeholders replaced
+func (m Messages) Message(issue ValidationIssue) string {
+	template, ok := m[issue.Code]
+	if !ok {
+		if template, ok = m[issue.Rule]; !ok {
+			return ""
+		}
+	}
+	value := ""
+	if issue.Value != nil {
+		value = fmt.Sprintf("%v", issue.Value)
+	}
+	return strings.NewReplacer("{tag}", issue.Tag, "{field}", issue.Field, "{value}", value).Replace(template)
+}
+
+// Localize replaces the messages of the errors and warnings of vr with those from catalog. Issues the
+// catalog has no message for keep their English message.
+func (vr *ValidationResult) Localize(catalog MessageCatalog) {
+	for _, issues := range [][]ValidationIssue{vr.Errors, vr.Warnings} {
+		for i := range issues {
+			if message := catalog.Message(issues[i]); message != "" {
+				issues[i].Message = message
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationResult_Localize(t *testing.T) {
+	file := mockValidatedFile()
+	file.FEDWireMessage.BusinessFunctionCode.BusinessFunctionCode = "ZZZ"
+	file.FEDWireMessage.InstructedAmount = mockInstructedAmount()
+	file.FEDWireMessage.InstructedAmount.CurrencyCode = "XAU"
+
+	result := file.ValidateDetailed()
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "W-3600-BFC-INVALID", result.Errors[0].Code)
+	require.Equal(t, "BusinessFunctionCode ZZZ is an invalid business function code", result.Errors[0].Message)
+
+	result.Localize(Messages{
+		"W-3600-BFC-INVALID": "{value} n'est pas un code de fonction commerciale valide",
+		RuleInvalid:          "{field} {tag} est invalide",
+	})
+	require.Equal(t, "ZZZ n'est pas un code de fonction commerciale valide", result.Errors[0].Message)
+	// the catalog has no message for the warning
+	require.Len(t, result.Warnings, 1)
+	require.Equal(t, "is not a national currency", result.Warnings[0].Message)
+
+	result.Localize(Messages{RuleCurrencyUnusual: "{field} {value} n'est pas une monnaie nationale"})
+	require.Equal(t, "instructedAmount.currencyCode XAU n'est pas une monnaie nationale", result.Warnings[0].Message)
+}
+
+func TestMessages_Message(t *testing.T) {
+	messages := Messages{RuleRequired: "{field} ({tag}) est obligatoire"}
+	require.Equal(t, "beneficiaryFI ({4100}) est obligatoire", messages.Message(ValidationIssue{
+		Code: "W-4100-TAG-REQUIRED", Tag: TagBeneficiaryFI, Field: "beneficiaryFI", Rule: RuleRequired,
+	}))
+	require.Empty(t, messages.Message(ValidationIssue{Code: "W-INVALID", Rule: RuleInvalid}))
+}
@@ -0,0 +1,231 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalization records a field value changed by Normalize
+type Normalization struct {
+	// Tag is the tag number of the field, e.g. {4200}
+	Tag string `json:"tag,omitempty"`
+	// Field is the JSON path of the field within FEDWireMessage, e.g. beneficiary.personal.name
+	Field string `json:"field"`
+	// Original is the value before normalization
+	Original string `json:"original"`
+	// Normalized is the value after normalization
+	Normalized string `json:"normalized"`
+	// Overflow is set when Normalized is longer than the field can hold, so writing it would truncate it
+	Overflow bool `json:"overflow,omitempty"`
+}
+
+// NormalizationOverflow returns a FieldError for the first of changes which no longer fits its field,
+// or nil when they all fit
+func NormalizationOverflow(changes []Normalization) error {
+	for _, change := range changes {
+		if change.Overflow {
+			return fieldError(change.Field, ErrNormalizedLength, change.Normalized)
+		}
+	}
+	return nil
+}
+
+// transliterations are the characters NormalizeText replaces that don't decompose into an ASCII letter
+var transliterations = map[rune]string{
+	'ß': "ss", 'ẞ': "SS", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE",
+	'ø': "o", 'Ø': "O", 'ł': "l", 'Ł': "L", 'đ': "d", 'Đ': "D",
+	'ð': "d", 'Ð': "D", 'þ': "th", 'Þ': "TH", 'ı': "i", 'ħ': "h", 'Ħ': "H",
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'",
+	'“': `"`, '”': `"`, '„': `"`, '‟': `"`, '″': `"`, '«': `"`, '»': `"`,
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-",
+	'…': "...", '×': "x",
+}
+
+// upperCaseFields are the fields holding codes which the wire format requires in uppercase
+var upperCaseFields = map[string]bool{
+	"AddressType":                    true,
+	"AdjustmentReasonCode":           true,
+	"AdviceCode":                     true,
+	"BusinessFunctionCode":           true,
+	"ChargeDetails":                  true,
+	"CountryCode":                    true,
+	"CreditDebitIndicator":           true,
+	"CurrencyCode":                   true,
+	"DocumentTypeCode":               true,
+	"IdentificationCode":             true,
+	"IdentificationType":             true,
+	"LocalInstrumentCode":            true,
+	"MessageDuplicationCode":         true,
+	"OrganizationIdentificationCode": true,
+	"PrivateIdentificationCode":      true,
+	"RemittanceLocationMethod":       true,
+	"SubTypeCode":                    true,
+	"SwiftFieldTag":                  true,
+	"TestProductionCode":             true,
+	"TransactionTypeCode":            true,
+	"TypeCode":                       true,
+}
+
+// NormalizeText transliterates s into the wire character set. Accented letters lose their accents
+// (é becomes e), ligatures and special letters are spelled out (ß becomes ss), typographic quotes and
+// dashes become their ASCII forms, whitespace becomes a space and other control characters are removed.
+// Characters without a transliteration are kept for Validate to reject.
+func NormalizeText(s string) string {
+	var buf strings.Builder
+	buf.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == ' ' || (r > ' ' && r < unicode.MaxASCII):
+			buf.WriteRune(r)
+		case unicode.IsSpace(r):
+			buf.WriteByte(' ')
+		case unicode.IsControl(r) || unicode.In(r, unicode.Cf):
+			// dropped
+		case transliterations[r] != "":
+			buf.WriteString(transliterations[r])
+		default:
+			buf.WriteString(decompose(r))
+		}
+	}
+	return buf.String()
+}
+
+// decompose returns the ASCII letters of r without their combining marks, or r when it has none
+func decompose(r rune) string {
+	var base strings.Builder
+	for _, d := range norm.NFD.String(string(r)) {
+		if d < unicode.MaxASCII {
+			base.WriteRune(d)
+		} else if !unicode.In(d, unicode.Mn) {
+			return string(r)
+		}
+	}
+	if base.Len() == 0 {
+		return string(r)
+	}
+	return base.String()
+}
+
+// Normalize runs NormalizeText over every text field of fwm and uppercases code fields, returning each
+// change made. UnstructuredAddenda.AddendaLength is recomputed when its addenda changes. Changes
+// which made a value longer than its field are marked as Overflow, see NormalizationOverflow.
+func (fwm *FEDWireMessage) Normalize() []Normalization {
+	var changes []Normalization
+	v := reflect.ValueOf(fwm).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Field(i); field.Kind() == reflect.Ptr && !field.IsNil() {
+			changes = append(changes, normalizeTag(field.Interface())...)
+		}
+	}
+	return changes
+}
+
+// Normalize normalizes the FEDWireMessage of f, see FEDWireMessage.Normalize
+func (f *File) Normalize() []Normalization {
+	return f.FEDWireMessage.Normalize()
+}
+
+var (
+	tagPathsOnce sync.Once
+	tagPaths     map[reflect.Type]string
+)
+
+// tagPath returns the JSON name of the FEDWireMessage field holding tags of type t
+func tagPath(t reflect.Type) string {
+	tagPathsOnce.Do(func() {
+		tagPaths = make(map[reflect.Type]string)
+		fwm := reflect.TypeOf(FEDWireMessage{})
+		for i := 0; i < fwm.NumField(); i++ {
+			tagPaths[fwm.Field(i).Type] = jsonName(fwm.Field(i))
+		}
+	})
+	return tagPaths[t]
+}
+
+// jsonName returns the name f is encoded with by encoding/json
+func jsonName(f reflect.StructField) string {
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return f.Name
+}
+
+// normalizeTag normalizes the fields of tag, a pointer to one of the tags of FEDWireMessage
+func normalizeTag(tag interface{}) []Normalization {
+	v := reflect.ValueOf(tag).Elem()
+	number := tagNumber(v)
+
+	changes, indexes := normalizeStruct(v, tagPath(reflect.TypeOf(tag)), number)
+	if ua, ok := tag.(*UnstructuredAddenda); ok && len(changes) > 0 && ua.AddendaLength != "" {
+		if length := ua.addendaLength(); length != ua.AddendaLength {
+			changes = append(changes, Normalization{
+				Tag:        number,
+				Field:      tagPath(reflect.TypeOf(tag)) + ".addendaLength",
+				Original:   ua.AddendaLength,
+				Normalized: length,
+			})
+			ua.AddendaLength = length
+		}
+	}
+	if written, ok := writtenTag(tag); ok {
+		for i, index := range indexes {
+			changes[i].Overflow = isTruncated(changes[i].Normalized, written.FieldByIndex(index).String())
+		}
+	}
+	return changes
+}
+
+// tagNumber returns the tag number held by v, a tag struct, or an empty string for tags without one
+func tagNumber(v reflect.Value) string {
+	if f := v.FieldByName("tag"); f.IsValid() && f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+// normalizeStruct normalizes the exported string fields of v and the structs nested in it, returning
+// the changes made and the index of each field changed
+func normalizeStruct(v reflect.Value, path, tag string) ([]Normalization, [][]int) {
+	var changes []Normalization
+	var indexes [][]int
+	walkStrings(v, path, nil, func(fieldPath string, index []int, sf reflect.StructField, field reflect.Value) {
+		original := field.String()
+		normalized := NormalizeText(original)
+		if upperCaseFields[sf.Name] {
+			normalized = strings.ToUpper(normalized)
+		}
+		if normalized != original {
+			field.SetString(normalized)
+			changes = append(changes, Normalization{Tag: tag, Field: fieldPath, Original: original, Normalized: normalized})
+			indexes = append(indexes, index)
+		}
+	})
+	return changes, indexes
+}
+
+// walkStrings calls fn with the JSON path and index of each exported string field of v, descending
+// into nested structs
+func walkStrings(v reflect.Value, path string, index []int, fn func(path string, index []int, sf reflect.StructField, field reflect.Value)) {
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if !sf.IsExported() || sf.Anonymous {
+			continue
+		}
+		fieldPath := path + "." + jsonName(sf)
+		fieldIndex := append(append([]int{}, index...), i)
+		switch field := v.Field(i); field.Kind() {
+		case reflect.Struct:
+			walkStrings(field, fieldPath, fieldIndex, fn)
+		case reflect.String:
+			fn(fieldPath, fieldIndex, sf, field)
+		}
+	}
+}
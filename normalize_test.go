@@ -0,0 +1,90 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeText(t *testing.T) {
+	require.Equal(t, "Jose Muller Strasse", NormalizeText("José Müller Strasse"))
+	require.Equal(t, "Grosse Strasse", NormalizeText("Große Straße"))
+	require.Equal(t, `"Smith" O'Brien - Lodz`, NormalizeText("“Smith” O’Brien — Łódź"))
+	require.Equal(t, "Line One Line Two", NormalizeText("Line One\nLine Two​\x07"))
+	require.Equal(t, "AEroskobing", NormalizeText("Ærøskøbing"))
+
+	// characters without a transliteration are left for Validate to reject
+	require.Equal(t, "北京", NormalizeText("北京"))
+	require.Equal(t, "Plain ASCII *", NormalizeText("Plain ASCII *"))
+}
+
+func TestFEDWireMessage_Normalize(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = "José Müller"
+	fwm.Beneficiary.Personal.IdentificationCode = "d"
+	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
+	fwm.UnstructuredAddenda.Addenda = "Straße"
+	fwm.UnstructuredAddenda.AddendaLength = "0006"
+
+	changes := fwm.Normalize()
+	require.Equal(t, []Normalization{
+		{Tag: TagBeneficiary, Field: "beneficiary.personal.identificationCode", Original: "d", Normalized: "D"},
+		{Tag: TagBeneficiary, Field: "beneficiary.personal.name", Original: "José Müller", Normalized: "Jose Muller"},
+		{Tag: TagUnstructuredAddenda, Field: "unstructuredAddenda.addenda", Original: "Straße", Normalized: "Strasse"},
+		{Tag: TagUnstructuredAddenda, Field: "unstructuredAddenda.addendaLength", Original: "0006", Normalized: "0007"},
+	}, changes)
+	require.Equal(t, "Jose Muller", fwm.Beneficiary.Personal.Name)
+
+	// normalizing again changes nothing
+	require.Empty(t, fwm.Normalize())
+}
+
+func TestFEDWireMessage_NormalizeOverflow(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = strings.Repeat("A", 34) + "ß"
+	fwm.Beneficiary.Personal.Address.AddressLineOne = "Große Straße"
+
+	changes := fwm.Normalize()
+	require.Equal(t, []Normalization{
+		{Tag: TagBeneficiary, Field: "beneficiary.personal.name", Original: strings.Repeat("A", 34) + "ß", Normalized: strings.Repeat("A", 34) + "ss", Overflow: true},
+		{Tag: TagBeneficiary, Field: "beneficiary.personal.address.addressLineOne", Original: "Große Straße", Normalized: "Grosse Strasse"},
+	}, changes)
+
+	err := NormalizationOverflow(changes)
+	require.ErrorIs(t, err, ErrNormalizedLength)
+	require.ErrorContains(t, err, "beneficiary.personal.name")
+	require.NoError(t, NormalizationOverflow(changes[1:]))
+
+	issue := fwm.IssueFor(err)
+	require.Equal(t, TagBeneficiary, issue.Tag)
+	require.Equal(t, "beneficiary.personal.name", issue.Field)
+	require.Equal(t, RuleLength, issue.Rule)
+}
+
+func TestReader_NormalizeCharset(t *testing.T) {
+	bs, err := os.ReadFile("./test/testdata/fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	input := strings.Replace(string(bs), "{4200}31234*Name*", "{4200}31234*Zoë “Name”*", 1)
+
+	_, err = NewReader(strings.NewReader(input)).Read()
+	require.ErrorContains(t, err, ErrNonAlphanumeric.Error())
+
+	r := NewReader(strings.NewReader(input))
+	file, err := r.ReadWithOpts(&ValidateOpts{NormalizeCharset: true})
+	require.NoError(t, err)
+	require.Equal(t, `Zoe "Name"`, file.FEDWireMessage.Beneficiary.Personal.Name)
+	require.Equal(t, []Normalization{{
+		Tag:        TagBeneficiary,
+		Field:      "beneficiary.personal.name",
+		Original:   "Zoë “Name”",
+		Normalized: `Zoe "Name"`,
+	}}, r.Normalizations())
+}
@@ -0,0 +1,235 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"time"
+)
+
+// optionFLineLength is the length of an OriginatorOptionF line, including its line code
+const optionFLineLength = 35
+
+// OptionF is the structured form of the coded lines of an OriginatorOptionF
+type OptionF struct {
+	// PartyIdentifier is an account (/123456) or a unique identifier (SOSE/123-456-789)
+	PartyIdentifier string `json:"partyIdentifier,omitempty"`
+	// Name from line code 1. Names too long for one line continue on further 1/ lines, split between words.
+	Name string `json:"name"`
+	// AddressLines from line code 2
+	AddressLines []string `json:"addressLines,omitempty"`
+	// CountryCode is the ISO 3166 country of line code 3
+	CountryCode string `json:"countryCode,omitempty"`
+	// Town of line code 3, optionally followed by a post code
+	Town string `json:"town,omitempty"`
+	// DateOfBirth from line code 4
+	DateOfBirth *time.Time `json:"dateOfBirth,omitempty"`
+	// PlaceOfBirth from line code 5, a country code and town (e.g. US/NEW YORK)
+	PlaceOfBirth string `json:"placeOfBirth,omitempty"`
+	// CustomerIdentification from line code 6, a country code, issuer and number (e.g. US/BANK/1234)
+	CustomerIdentification string `json:"customerIdentification,omitempty"`
+	// NationalIdentityCountryCode is the ISO 3166 country of line code 7
+	NationalIdentityCountryCode string `json:"nationalIdentityCountryCode,omitempty"`
+	// NationalIdentityNumber of line code 7
+	NationalIdentityNumber string `json:"nationalIdentityNumber,omitempty"`
+	// AdditionalInformation from line code 8
+	AdditionalInformation []string `json:"additionalInformation,omitempty"`
+
+	// validator is composed for data validation
+	validator
+}
+
+// OptionF decodes the Name and lines of oof. Country codes must be ISO 3166, the date of birth a
+// valid CCYYMMDD date in any century and the lines in line code order. Lines which must be used
+// together, such as 2/ with 3/, aren't checked.
+func (oof *OriginatorOptionF) OptionF() (*OptionF, error) {
+	of := &OptionF{PartyIdentifier: oof.PartyIdentifier}
+	if err := of.decodeLine("Name", oof.Name); err != nil {
+		return nil, err
+	}
+	previous := OptionFName
+	for _, line := range []struct {
+		field, value string
+	}{{"LineOne", oof.LineOne}, {"LineTwo", oof.LineTwo}, {"LineThree", oof.LineThree}} {
+		if err := of.decodeLine(line.field, line.value); err != nil {
+			return nil, err
+		}
+		if value := strings.TrimSpace(line.value); value != "" {
+			if err := nextOptionFLine(previous, value[:1]); err != nil {
+				return nil, fieldError(line.field, err, line.value)
+			}
+			previous = value[:1]
+		}
+	}
+	return of, nil
+}
+
+// decodeLine adds a coded line to of
+func (of *OptionF) decodeLine(field, line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil
+	}
+	if len(line) < 3 || line[1] != '/' {
+		return fieldError(field, ErrOptionFLine, line)
+	}
+	value := strings.TrimSpace(line[2:])
+	switch line[:1] {
+	case OptionFName:
+		if of.Name != "" {
+			of.Name += " "
+		}
+		of.Name += value
+	case OptionFAddress:
+		of.AddressLines = append(of.AddressLines, value)
+	case OptionFCountryTown:
+		country, town, _ := strings.Cut(value, "/")
+		if err := of.isCountryCode(country); err != nil {
+			return fieldError(field, err, country)
+		}
+		of.CountryCode, of.Town = country, town
+	case OptionFDOB:
+		dob, err := time.Parse("20060102", value)
+		if err != nil {
+			return fieldError(field, ErrValidDate, value)
+		}
+		of.DateOfBirth = &dob
+	case OptionFBirthPlace:
+		of.PlaceOfBirth = value
+	case OptionFCustomerIdentificationNumber:
+		of.CustomerIdentification = value
+	case OptionFNationalIdentityNumber:
+		country, number, _ := strings.Cut(value, "/")
+		if err := of.isCountryCode(country); err != nil {
+			return fieldError(field, err, country)
+		}
+		of.NationalIdentityCountryCode, of.NationalIdentityNumber = country, number
+	case OptionFAdditionalInformation:
+		of.AdditionalInformation = append(of.AdditionalInformation, value)
+	default:
+		return fieldError(field, ErrOptionFLine, line)
+	}
+	return nil
+}
+
+// SetOptionF encodes of into the PartyIdentifier, Name and lines of oof. An error is returned when of
+// has an invalid country code or needs more than the three lines oof holds.
+func (oof *OriginatorOptionF) SetOptionF(of *OptionF) error {
+	lines, err := of.encode()
+	if err != nil {
+		return err
+	}
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], OptionFName+"/") {
+		return fieldError("Name", ErrOptionFName, of.Name)
+	}
+	if len(lines) > 4 {
+		return fieldError("OptionF", ErrOptionFLines, len(lines)-1)
+	}
+	lines = append(lines, "", "", "")
+
+	oof.PartyIdentifier = of.PartyIdentifier
+	oof.Name = lines[0]
+	oof.LineOne, oof.LineTwo, oof.LineThree = lines[1], lines[2], lines[3]
+	return nil
+}
+
+// encode returns the coded lines of of in line code order
+func (of *OptionF) encode() ([]string, error) {
+	var lines []string
+	add := func(code, value string) {
+		if value != "" {
+			lines = append(lines, code+"/"+value)
+		}
+	}
+
+	for _, name := range wrapOptionFLine(of.Name) {
+		add(OptionFName, name)
+	}
+	for _, address := range of.AddressLines {
+		add(OptionFAddress, address)
+	}
+	if of.CountryCode != "" {
+		if err := of.isCountryCode(of.CountryCode); err != nil {
+			return nil, fieldError("CountryCode", err, of.CountryCode)
+		}
+		add(OptionFCountryTown, strings.TrimSuffix(of.CountryCode+"/"+of.Town, "/"))
+	}
+	if of.DateOfBirth != nil {
+		add(OptionFDOB, of.DateOfBirth.Format("20060102"))
+	}
+	add(OptionFBirthPlace, of.PlaceOfBirth)
+	add(OptionFCustomerIdentificationNumber, of.CustomerIdentification)
+	if of.NationalIdentityNumber != "" {
+		if err := of.isCountryCode(of.NationalIdentityCountryCode); err != nil {
+			return nil, fieldError("NationalIdentityCountryCode", err, of.NationalIdentityCountryCode)
+		}
+		add(OptionFNationalIdentityNumber, of.NationalIdentityCountryCode+"/"+of.NationalIdentityNumber)
+	}
+	for _, info := range of.AdditionalInformation {
+		add(OptionFAdditionalInformation, info)
+	}
+	return lines, nil
+}
+
+// wrapOptionFLine splits s between words into values that fit a line after its line code, filled like
+// the lines of WrapText
+func wrapOptionFLine(s string) []string {
+	var out []string
+	for words := strings.Fields(s); len(words) > 0; {
+		var line string
+		line, words = fillLine(words, optionFLineLength-2)
+		out = append(out, line)
+	}
+	return out
+}
+
+// nextOptionFLine returns ErrOptionFLineOrder unless a line with code may follow a line with previous.
+// Lines are in line code order and only names (1/), addresses (2/) and additional information (8/)
+// continue on further lines.
+func nextOptionFLine(previous, code string) error {
+	if code < previous {
+		return ErrOptionFLineOrder
+	}
+	if code == previous && code != OptionFName && code != OptionFAddress && code != OptionFAdditionalInformation {
+		return ErrOptionFLineOrder
+	}
+	return nil
+}
+
+// OptionFFromPersonal converts the name, identifier and address of p into Option F form. The last
+// address line becomes the town of countryCode when one is given. Account identifiers (such as a
+// DemandDepositAccountNumber) become a /account PartyIdentifier and personal identification codes
+// the matching unique identifier, e.g. CCPT/ for a PassportNumber.
+func OptionFFromPersonal(p Personal, countryCode string) *OptionF {
+	of := &OptionF{Name: p.Name}
+	if p.Identifier != "" {
+		switch p.IdentificationCode {
+		case PassportNumber:
+			of.PartyIdentifier = PartyIdentifierPassportNumber + "/" + p.Identifier
+		case TaxIdentificationNumber:
+			of.PartyIdentifier = PartyIdentifierTaxIdentificationNumber + "/" + p.Identifier
+		case DriversLicenseNumber:
+			of.PartyIdentifier = PartyIdentifierDriversLicenseNumber + "/" + p.Identifier
+		case AlienRegistrationNumber:
+			of.PartyIdentifier = PartyIdentifierAlienRegistrationNumber + "/" + p.Identifier
+		case CorporateIdentification, OtherIdentification:
+			of.PartyIdentifier = PartyIdentifierCustomerIdentificationNumber + "/" + p.Identifier
+		default:
+			of.PartyIdentifier = "/" + p.Identifier
+		}
+	}
+
+	for _, line := range []string{p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree} {
+		if line = strings.TrimSpace(line); line != "" {
+			of.AddressLines = append(of.AddressLines, line)
+		}
+	}
+	if countryCode != "" && len(of.AddressLines) > 0 {
+		of.CountryCode = countryCode
+		of.Town = of.AddressLines[len(of.AddressLines)-1]
+		of.AddressLines = of.AddressLines[:len(of.AddressLines)-1]
+	}
+	return of
+}
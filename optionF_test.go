@@ -0,0 +1,138 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOriginatorOptionF_OptionF(t *testing.T) {
+	oof := mockOriginatorOptionF()
+	oof.Name = "1/SMITH JOHN"
+	oof.LineOne = "2/123 MAIN STREET"
+	oof.LineTwo = "3/US/NEW YORK, NY 10000"
+	oof.LineThree = "4/19700131"
+
+	of, err := oof.OptionF()
+	require.NoError(t, err)
+	dob := time.Date(1970, time.January, 31, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, &OptionF{
+		PartyIdentifier: oof.PartyIdentifier,
+		Name:            "SMITH JOHN",
+		AddressLines:    []string{"123 MAIN STREET"},
+		CountryCode:     "US",
+		Town:            "NEW YORK, NY 10000",
+		DateOfBirth:     &dob,
+	}, of)
+
+	// encoding gives back the same lines
+	encoded := NewOriginatorOptionF()
+	require.NoError(t, encoded.SetOptionF(of))
+	require.Equal(t, oof.String(), encoded.String())
+	require.NoError(t, encoded.Validate())
+
+	oof.LineTwo = "3/XX/NOWHERE"
+	_, err = oof.OptionF()
+	require.EqualError(t, err, fieldError("LineTwo", ErrNonCountryCode, "XX").Error())
+
+	oof.LineTwo = "7/US/111-22-3456"
+	oof.LineThree = "4/19701331"
+	_, err = oof.OptionF()
+	require.EqualError(t, err, fieldError("LineThree", ErrValidDate, "19701331").Error())
+
+	oof.LineThree = "9/UNKNOWN"
+	_, err = oof.OptionF()
+	require.EqualError(t, err, fieldError("LineThree", ErrOptionFLine, "9/UNKNOWN").Error())
+
+	// lines are in line code order
+	oof.LineOne = "2/123 MAIN STREET"
+	oof.LineTwo = "4/19700131"
+	oof.LineThree = "3/US/NEW YORK, NY 10000"
+	_, err = oof.OptionF()
+	require.EqualError(t, err, fieldError("LineThree", ErrOptionFLineOrder, oof.LineThree).Error())
+	require.EqualError(t, oof.Validate(), fieldError("LineThree", ErrOptionFLineOrder, oof.LineThree).Error())
+
+	oof.LineTwo = "3/US/NEW YORK"
+	_, err = oof.OptionF()
+	require.EqualError(t, err, fieldError("LineThree", ErrOptionFLineOrder, oof.LineThree).Error())
+
+	// names, addresses and additional information continue on further lines
+	oof.LineOne = "1/JOHN"
+	oof.LineTwo = "2/123 MAIN STREET"
+	oof.LineThree = "2/NEW YORK"
+	_, err = oof.OptionF()
+	require.NoError(t, err)
+	require.NoError(t, oof.Validate())
+}
+
+func TestOriginatorOptionF_SetOptionF(t *testing.T) {
+	of := &OptionF{
+		PartyIdentifier:             "TXID/123-45-6789",
+		Name:                        "A VERY LONG NAME THAT NEEDS MORE THAN ONE LINE",
+		NationalIdentityCountryCode: "GB",
+		NationalIdentityNumber:      "AB123456C",
+	}
+	oof := NewOriginatorOptionF()
+	require.NoError(t, oof.SetOptionF(of))
+	require.Equal(t, "1/A VERY LONG NAME THAT NEEDS MORE", oof.Name)
+	require.Equal(t, "1/THAN ONE LINE", oof.LineOne)
+	require.Equal(t, "7/GB/AB123456C", oof.LineTwo)
+	require.Empty(t, oof.LineThree)
+	require.NoError(t, oof.Validate())
+
+	decoded, err := oof.OptionF()
+	require.NoError(t, err)
+	require.Equal(t, of.Name, decoded.Name)
+
+	of.AddressLines = []string{"LINE 1", "LINE 2"}
+	require.EqualError(t, oof.SetOptionF(of), fieldError("OptionF", ErrOptionFLines, 4).Error())
+
+	of.AddressLines = nil
+	of.NationalIdentityCountryCode = "ZZ"
+	require.EqualError(t, oof.SetOptionF(of), fieldError("NationalIdentityCountryCode", ErrNonCountryCode, "ZZ").Error())
+
+	require.EqualError(t, oof.SetOptionF(&OptionF{}), fieldError("Name", ErrOptionFName, "").Error())
+}
+
+func TestOptionFFromPersonal(t *testing.T) {
+	p := Personal{
+		IdentificationCode: PassportNumber,
+		Identifier:         "X1234567",
+		Name:               "SMITH JOHN",
+		Address: Address{
+			AddressLineOne: "123 MAIN STREET",
+			AddressLineTwo: "NEW YORK, NY 10000",
+		},
+	}
+	of := OptionFFromPersonal(p, "US")
+	require.Equal(t, &OptionF{
+		PartyIdentifier: "CCPT/X1234567",
+		Name:            "SMITH JOHN",
+		AddressLines:    []string{"123 MAIN STREET"},
+		CountryCode:     "US",
+		Town:            "NEW YORK, NY 10000",
+	}, of)
+
+	oof := NewOriginatorOptionF()
+	require.NoError(t, oof.SetOptionF(of))
+	require.NoError(t, oof.Validate())
+	require.Equal(t, "3/US/NEW YORK, NY 10000", oof.LineTwo)
+
+	p.IdentificationCode = DemandDepositAccountNumber
+	require.Equal(t, "/X1234567", OptionFFromPersonal(p, "").PartyIdentifier)
+	require.Len(t, OptionFFromPersonal(p, "").AddressLines, 2)
+}
+
+func TestValidator_isCountryCode(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.isCountryCode("US"))
+	require.NoError(t, v.isCountryCode("DE"))
+	for _, code := range []string{"", "us", "USA", "XX", "EU", "1"} {
+		require.ErrorIs(t, v.isCountryCode(code), ErrNonCountryCode, code)
+	}
+}
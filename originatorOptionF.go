@@ -210,6 +210,18 @@ func (oof *OriginatorOptionF) Validate() error {
 	if err := oof.validateOptionFLine(oof.LineThree); err != nil {
 		return fieldError("LineThree", err, oof.LineThree)
 	}
+	previous := OptionFName
+	for _, line := range []struct {
+		field, value string
+	}{{"LineOne", oof.LineOne}, {"LineTwo", oof.LineTwo}, {"LineThree", oof.LineThree}} {
+		if line.value == "" {
+			continue
+		}
+		if err := nextOptionFLine(previous, line.value[:1]); err != nil {
+			return fieldError(line.field, err, line.value)
+		}
+		previous = line.value[:1]
+	}
 	return nil
 }
 
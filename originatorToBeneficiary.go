@@ -164,3 +164,19 @@ func (ob *OriginatorToBeneficiary) FormatLineThree(options FormatOptions) string
 func (ob *OriginatorToBeneficiary) FormatLineFour(options FormatOptions) string {
 	return ob.formatAlphaField(ob.LineFour, 35, options)
 }
+
+// SetText word-wraps text across the four lines of OriginatorToBeneficiary. OriginatorToBeneficiary is
+// left unchanged when text doesn't fit or has characters outside the wire character set.
+func (ob *OriginatorToBeneficiary) SetText(text string) error {
+	lines, err := WrapText(text, 35, 35, 35, 35)
+	if err != nil {
+		return err
+	}
+	ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+	return nil
+}
+
+// Text joins the lines of OriginatorToBeneficiary into a single paragraph
+func (ob *OriginatorToBeneficiary) Text() string {
+	return JoinText(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour)
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/moov-io/wire/wirepb"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// FileToProto returns f as the Protobuf message of wirepb.
+func FileToProto(f *File) (*wirepb.File, error) {
+	bs, err := json.Marshal(f)
+	if err != nil {
+		return nil, fmt.Errorf("problem encoding File: %v", err)
+	}
+	out := &wirepb.File{}
+	if err := protojson.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("problem encoding File: %v", err)
+	}
+	return out, nil
+}
+
+// FileFromProto returns the File of a Protobuf message from wirepb.
+//
+// The File returned may not be valid and callers should confirm with Validate().
+func FileFromProto(pb *wirepb.File) (*File, error) {
+	bs, err := protojson.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading File: %v", err)
+	}
+	file := NewFile()
+	if err := json.Unmarshal(bs, file); err != nil {
+		return nil, fmt.Errorf("problem reading File: %v", err)
+	}
+	return file, nil
+}
+
+// MarshalProto encodes f in the Protobuf wire format, following the schema of wirepb/wire.proto.
+func (f *File) MarshalProto() ([]byte, error) {
+	pb, err := FileToProto(f)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// UnmarshalProto decodes data, a File in the Protobuf wire format, into f.
+//
+// Fields unknown to this release, written by a newer one, are ignored.
+func (f *File) UnmarshalProto(data []byte) error {
+	pb := &wirepb.File{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return fmt.Errorf("problem reading File: %v", err)
+	}
+	file, err := FileFromProto(pb)
+	if err != nil {
+		return err
+	}
+	*f = *file
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/moov-io/wire/wirepb"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// readJSONTestdata returns each File of test/testdata/*.json, keyed by file name
+func readJSONTestdata(t *testing.T) map[string]*File {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join("test", "testdata", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	files := make(map[string]*File)
+	for _, path := range paths {
+		bs, err := os.ReadFile(path)
+		require.NoError(t, err)
+		file, err := FileFromJSON(bs)
+		require.NoError(t, err, path)
+		files[filepath.Base(path)] = file
+	}
+	return files
+}
+
+func TestFile__Proto(t *testing.T) {
+	for name, file := range readJSONTestdata(t) {
+		t.Run(name, func(t *testing.T) {
+			bs, err := file.MarshalProto()
+			require.NoError(t, err)
+
+			var out File
+			require.NoError(t, out.UnmarshalProto(bs))
+			require.Equal(t, file, &out)
+		})
+	}
+}
+
+func TestFile__UnmarshalProtoInvalid(t *testing.T) {
+	var file File
+	require.Error(t, file.UnmarshalProto([]byte("not protobuf")))
+}
+
+// TestProto__Fields checks wirepb/wire.proto has a field for every field of the structs within File,
+// in which case it needs to be regenerated with go generate ./wirepb
+func TestProto__Fields(t *testing.T) {
+	var check func(typ reflect.Type, desc protoreflect.MessageDescriptor)
+	check = func(typ reflect.Type, desc protoreflect.MessageDescriptor) {
+		require.Equal(t, typ.Name(), string(desc.Name()))
+		for i := 0; i < typ.NumField(); i++ {
+			sf := typ.Field(i)
+			if !sf.IsExported() || sf.Anonymous || sf.Tag.Get("json") == "-" {
+				continue
+			}
+			field := desc.Fields().ByJSONName(jsonName(sf))
+			require.NotNil(t, field, "%s.%s", typ.Name(), sf.Name)
+			if ft := sf.Type; ft.Kind() == reflect.Ptr || ft.Kind() == reflect.Struct {
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				check(ft, field.Message())
+			}
+		}
+	}
+	check(reflect.TypeOf(File{}), (&wirepb.File{}).ProtoReflect().Descriptor())
+}
@@ -6,6 +6,8 @@ package wire
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"regexp"
@@ -13,6 +15,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/moov-io/base"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Reader reads records from a ACH-encoded file.
@@ -34,10 +37,19 @@ type Reader struct {
 	errors base.ErrorList
 	// headerData holds header static data for file
 	headerData string
+	// opts are the validation overrides passed to ReadWithOpts
+	opts *ValidateOpts
+	// normalizations holds the changes made to tags read when opts.NormalizeCharset is set
+	normalizations []Normalization
+	// tracer starts the spans of each tag parsed
+	tracer trace.Tracer
 }
 
 var (
 	tagRegex = regexp.MustCompile(`{([0-9]{4})}`)
+
+	// ErrFileValidation wraps the error of validating a file once every tag has been read
+	ErrFileValidation = errors.New("file validation failed")
 )
 
 // error returns a new ParseError based on err
@@ -77,14 +89,48 @@ func NewReader(r io.Reader, opts ...FilePropertyFunc) *Reader {
 // on the first character of each line. It also enforces FED Wire formatting rules and returns
 // the appropriate error if issues are found.
 func (r *Reader) Read() (File, error) {
-	return r.read(nil)
+	return r.read(context.Background(), nil)
 }
 
 func (r *Reader) ReadWithOpts(opts *ValidateOpts) (File, error) {
-	return r.read(opts)
+	return r.read(context.Background(), opts)
+}
+
+// ReadWithContext reads the file like ReadWithOpts, recording OpenTelemetry spans for reading, parsing
+// each tag and validating as children of any span in ctx.
+func (r *Reader) ReadWithContext(ctx context.Context, opts *ValidateOpts) (File, error) {
+	return r.read(ctx, opts)
+}
+
+// Normalizations returns the changes made to the tags read when ValidateOpts.NormalizeCharset is set
+func (r *Reader) Normalizations() []Normalization {
+	return r.normalizations
+}
+
+// normalize normalizes tag before it's validated when ValidateOpts.NormalizeCharset is set
+func (r *Reader) normalize(tag interface{}) {
+	if r.opts != nil && r.opts.NormalizeCharset {
+		r.normalizations = append(r.normalizations, normalizeTag(tag)...)
+	}
 }
 
-func (r *Reader) read(opts *ValidateOpts) (File, error) {
+func (r *Reader) read(ctx context.Context, opts *ValidateOpts) (file File, err error) {
+	r.tracer = tracer()
+	ctx, span := r.tracer.Start(ctx, "wire.Reader.read")
+	defer func() {
+		// files hold a single message
+		messages := 0
+		if r.lineNum > 0 {
+			messages = 1
+		}
+		span.SetAttributes(
+			AttributeTagCount.Int(r.lineNum),
+			AttributeMessageCount.Int(messages),
+			file.FEDWireMessage.businessFunctionCodeAttribute(),
+		)
+		endSpan(span, err)
+	}()
+
 	spiltString := func(line string) []string {
 
 		// strip new lines
@@ -103,13 +149,14 @@ func (r *Reader) read(opts *ValidateOpts) (File, error) {
 	}
 
 	r.lineNum = 0
+	r.opts = opts
 	// read through the entire file
 	for r.scanner.Scan() {
 		line := r.scanner.Text()
 		for _, subLine := range spiltString(line) {
 			r.lineNum++
 			r.line = subLine
-			if err := r.parseLine(); err != nil {
+			if err := r.parseTag(ctx); err != nil {
 				r.errors.Add(err)
 			}
 		}
@@ -122,15 +169,26 @@ func (r *Reader) read(opts *ValidateOpts) (File, error) {
 		if opts != nil {
 			r.File.SetValidation(opts)
 		}
-		err := r.File.Validate()
+		err := r.File.ValidateWithContext(ctx)
 		if err == nil {
 			return r.File, nil
 		}
-		r.errors.Add(fmt.Errorf("file validation failed: %v", err))
+		r.errors.Add(fmt.Errorf("%w: %w", ErrFileValidation, err))
 	}
 	return r.File, r.errors
 }
 
+// parseTag parses the current line in a span which is a child of any span in ctx
+func (r *Reader) parseTag(ctx context.Context) error {
+	_, span := r.tracer.Start(ctx, "wire.Reader.parseTag", trace.WithAttributes(
+		AttributeTag.String(tagRegex.FindString(r.line)),
+		AttributeLine.Int(r.lineNum),
+	))
+	err := r.parseLine()
+	endSpan(span, err)
+	return err
+}
+
 func (r *Reader) parseLine() error { //nolint:gocyclo
 	if n := utf8.RuneCountInString(r.line); n < 6 {
 		return fmt.Errorf("line %q is too short for tag", r.line)
@@ -392,6 +450,7 @@ func (r *Reader) parseSenderSupplied() error {
 	if err := ss.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ss)
 	if err := ss.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -405,6 +464,7 @@ func (r *Reader) parseTypeSubType() error {
 	if err := tst.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(tst)
 	if err := tst.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -418,6 +478,7 @@ func (r *Reader) parseInputMessageAccountabilityData() error {
 	if err := imad.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(imad)
 	if err := imad.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -431,6 +492,7 @@ func (r *Reader) parseAmount() error {
 	if err := amt.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(amt)
 	if err := amt.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -444,6 +506,7 @@ func (r *Reader) parseSenderDepositoryInstitution() error {
 	if err := sdi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(sdi)
 	if err := sdi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -457,6 +520,7 @@ func (r *Reader) parseReceiverDepositoryInstitution() error {
 	if err := rdi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(rdi)
 	if err := rdi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -470,6 +534,7 @@ func (r *Reader) parseBusinessFunctionCode() error {
 	if err := bfc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(bfc)
 	if err := bfc.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -483,6 +548,7 @@ func (r *Reader) parseSenderReference() error {
 	if err := sr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(sr)
 	if err := sr.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -496,6 +562,7 @@ func (r *Reader) parsePreviousMessageIdentifier() error {
 	if err := pmi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(pmi)
 	if err := pmi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -509,6 +576,7 @@ func (r *Reader) parseLocalInstrument() error {
 	if err := li.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(li)
 	if err := li.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -522,6 +590,7 @@ func (r *Reader) parsePaymentNotification() error {
 	if err := pn.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(pn)
 	if err := pn.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -535,6 +604,7 @@ func (r *Reader) parseCharges() error {
 	if err := c.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(c)
 	if err := c.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -548,6 +618,7 @@ func (r *Reader) parseInstructedAmount() error {
 	if err := ia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ia)
 	if err := ia.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -561,6 +632,7 @@ func (r *Reader) parseExchangeRate() error {
 	if err := eRate.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(eRate)
 	if err := eRate.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -574,6 +646,7 @@ func (r *Reader) parseBeneficiaryIntermediaryFI() error {
 	if err := bifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(bifi)
 	if err := bifi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -587,6 +660,7 @@ func (r *Reader) parseBeneficiaryFI() error {
 	if err := bfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(bfi)
 	if err := bfi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -600,6 +674,7 @@ func (r *Reader) parseBeneficiary() error {
 	if err := ben.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ben)
 	if err := ben.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -613,6 +688,7 @@ func (r *Reader) parseBeneficiaryReference() error {
 	if err := br.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(br)
 	if err := br.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -626,6 +702,7 @@ func (r *Reader) parseAccountDebitedDrawdown() error {
 	if err := debitDD.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(debitDD)
 	if err := debitDD.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -639,6 +716,7 @@ func (r *Reader) parseOriginator() error {
 	if err := o.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(o)
 	if err := o.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -652,6 +730,7 @@ func (r *Reader) parseOriginatorOptionF() error {
 	if err := oof.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(oof)
 	if err := oof.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -665,6 +744,7 @@ func (r *Reader) parseOriginatorFI() error {
 	if err := ofi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ofi)
 	if err := ofi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -678,6 +758,7 @@ func (r *Reader) parseInstructingFI() error {
 	if err := ifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ifi)
 	if err := ifi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -691,6 +772,7 @@ func (r *Reader) parseAccountCreditedDrawdown() error {
 	if err := creditDD.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(creditDD)
 	if err := creditDD.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -704,6 +786,7 @@ func (r *Reader) parseOriginatorToBeneficiary() error {
 	if err := ob.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ob)
 	if err := ob.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -717,6 +800,7 @@ func (r *Reader) parseFIReceiverFI() error {
 	if err := firfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(firfi)
 	if err := firfi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -730,6 +814,7 @@ func (r *Reader) parseFIDrawdownDebitAccountAdvice() error {
 	if err := debitDDAdvice.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(debitDDAdvice)
 	if err := debitDDAdvice.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -743,6 +828,7 @@ func (r *Reader) parseFIIntermediaryFI() error {
 	if err := fiifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(fiifi)
 	if err := fiifi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -756,6 +842,7 @@ func (r *Reader) parseFIIntermediaryFIAdvice() error {
 	if err := fiifia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(fiifia)
 	if err := fiifia.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -769,6 +856,7 @@ func (r *Reader) parseFIBeneficiaryFI() error {
 	if err := fibfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(fibfi)
 	if err := fibfi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -782,6 +870,7 @@ func (r *Reader) parseFIBeneficiaryFIAdvice() error {
 	if err := fibfia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(fibfia)
 	if err := fibfia.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -795,6 +884,7 @@ func (r *Reader) parseFIBeneficiary() error {
 	if err := fib.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(fib)
 	if err := fib.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -808,6 +898,7 @@ func (r *Reader) parseFIBeneficiaryAdvice() error {
 	if err := fiba.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(fiba)
 	if err := fiba.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -821,6 +912,7 @@ func (r *Reader) parseFIPaymentMethodToBeneficiary() error {
 	if err := pm.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(pm)
 	if err := pm.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -834,6 +926,7 @@ func (r *Reader) parseFIAdditionalFIToFI() error {
 	if err := fifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(fifi)
 	if err := fifi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -847,6 +940,7 @@ func (r *Reader) parseCurrencyInstructedAmount() error {
 	if err := cia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(cia)
 	if err := cia.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -860,6 +954,7 @@ func (r *Reader) parseOrderingCustomer() error {
 	if err := oc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(oc)
 	if err := oc.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -873,6 +968,7 @@ func (r *Reader) parseOrderingInstitution() error {
 	if err := oi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(oi)
 	if err := oi.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -886,6 +982,7 @@ func (r *Reader) parseIntermediaryInstitution() error {
 	if err := ii.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ii)
 	if err := ii.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -899,6 +996,7 @@ func (r *Reader) parseInstitutionAccount() error {
 	if err := iAccount.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(iAccount)
 	if err := iAccount.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -912,6 +1010,7 @@ func (r *Reader) parseBeneficiaryCustomer() error {
 	if err := bc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(bc)
 	if err := bc.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -925,6 +1024,7 @@ func (r *Reader) parseRemittance() error {
 	if err := ri.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ri)
 	if err := ri.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -938,6 +1038,7 @@ func (r *Reader) parseSenderToReceiver() error {
 	if err := sr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(sr)
 	if err := sr.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -948,9 +1049,11 @@ func (r *Reader) parseSenderToReceiver() error {
 func (r *Reader) parseUnstructuredAddenda() error {
 	r.tagName = "UnstructuredAddenda"
 	ua := new(UnstructuredAddenda)
-	if err := ua.Parse(r.line); err != nil {
+	repair := r.opts != nil && r.opts.RepairAddendaLength
+	if err := ua.parse(r.line, repair); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ua)
 	if err := ua.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -964,6 +1067,7 @@ func (r *Reader) parseRelatedRemittance() error {
 	if err := rr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(rr)
 	if err := rr.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -977,6 +1081,7 @@ func (r *Reader) parseRemittanceOriginator() error {
 	if err := ro.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ro)
 	if err := ro.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -990,6 +1095,7 @@ func (r *Reader) parseRemittanceBeneficiary() error {
 	if err := rb.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(rb)
 	if err := rb.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1003,6 +1109,7 @@ func (r *Reader) parsePrimaryRemittanceDocument() error {
 	if err := prd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(prd)
 	if err := prd.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1016,6 +1123,7 @@ func (r *Reader) parseActualAmountPaid() error {
 	if err := aap.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(aap)
 	if err := aap.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1029,6 +1137,7 @@ func (r *Reader) parseGrossAmountRemittanceDocument() error {
 	if err := gard.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(gard)
 	if err := gard.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1042,6 +1151,7 @@ func (r *Reader) parseAmountNegotiatedDiscount() error {
 	if err := nd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(nd)
 	if err := nd.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1055,6 +1165,7 @@ func (r *Reader) parseAdjustment() error {
 	if err := adj.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(adj)
 	if err := adj.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1068,6 +1179,7 @@ func (r *Reader) parseDateRemittanceDocument() error {
 	if err := drd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(drd)
 	if err := drd.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1081,6 +1193,7 @@ func (r *Reader) parseSecondaryRemittanceDocument() error {
 	if err := srd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(srd)
 	if err := srd.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1094,6 +1207,7 @@ func (r *Reader) parseRemittanceFreeText() error {
 	if err := rft.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(rft)
 	if err := rft.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1107,6 +1221,7 @@ func (r *Reader) parseServiceMessage() error {
 	if err := sm.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(sm)
 	if err := sm.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1120,6 +1235,7 @@ func (r *Reader) parseMessageDisposition() error {
 	if err := md.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(md)
 	if err := md.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1133,6 +1249,7 @@ func (r *Reader) parseReceiptTimeStamp() error {
 	if err := rts.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(rts)
 	if err := rts.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1146,6 +1263,7 @@ func (r *Reader) parseOutputMessageAccountabilityData() error {
 	if err := omad.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(omad)
 	if err := omad.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1159,6 +1277,7 @@ func (r *Reader) parseErrorWire() error {
 	if err := ew.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
+	r.normalize(ew)
 	if err := ew.Validate(); err != nil {
 		return r.parseError(err)
 	}
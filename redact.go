@@ -0,0 +1,252 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/base"
+)
+
+// Mask is how a sensitive value is redacted
+type Mask string
+
+const (
+	// MaskNone keeps the value
+	MaskNone Mask = "none"
+	// MaskLastFour replaces all but the last four characters with *, e.g. *****6789
+	MaskLastFour Mask = "last4"
+	// MaskHash replaces the value with a SHA-256 hash, keyed by RedactionPolicy.HashKey when one is set,
+	// so the same value can be matched across messages without revealing it
+	MaskHash Mask = "hash"
+	// MaskHidden replaces the value with ***
+	MaskHidden Mask = "hidden"
+)
+
+// RedactionPolicy sets how the sensitive fields of the party tags are redacted
+type RedactionPolicy struct {
+	// Identifiers is the mask of account numbers and other identifiers of a party
+	Identifiers Mask
+	// PII is the mask of names, addresses, contact details and free text about a party
+	PII Mask
+	// HashKey is the HMAC key of MaskHash. Without a key values are hashed with plain SHA-256.
+	HashKey []byte
+}
+
+// DefaultRedactionPolicy shows the last four characters of identifiers and hides other personal data
+var DefaultRedactionPolicy = RedactionPolicy{Identifiers: MaskLastFour, PII: MaskHidden}
+
+// partyTags are the FEDWireMessage fields holding details of the originator, beneficiary or another party,
+// or free text which may name them. Tags between financial institutions, such as FIReceiverFI and
+// SenderToReceiver, and ServiceMessage are kept as they aren't about the parties to a payment.
+var partyTags = []string{
+	"PaymentNotification",
+	"Beneficiary",
+	"AccountDebitedDrawdown",
+	"Originator",
+	"OriginatorOptionF",
+	"AccountCreditedDrawdown",
+	"OriginatorToBeneficiary",
+	"FIBeneficiary",
+	"FIBeneficiaryAdvice",
+	"OrderingCustomer",
+	"BeneficiaryCustomer",
+	"Remittance",
+	"UnstructuredAddenda",
+	"RelatedRemittance",
+	"RemittanceOriginator",
+	"RemittanceBeneficiary",
+	"RemittanceFreeText",
+}
+
+// identifierFields are the fields of party tags redacted by RedactionPolicy.Identifiers
+var identifierFields = map[string]bool{
+	"Identifier":                  true,
+	"PartyIdentifier":             true,
+	"DrawdownCreditAccountNumber": true,
+	"IdentificationNumber":        true,
+	"RemittanceIdentification":    true,
+}
+
+// codeFields are the fields of party tags which hold codes rather than personal data and are kept
+var codeFields = map[string]bool{
+	"AddendaLength":                true,
+	"AddressType":                  true,
+	"AdviceCode":                   true,
+	"Country":                      true,
+	"CountryOfResidence":           true,
+	"EndToEndIdentification":       true,
+	"IdentificationCode":           true,
+	"IdentificationNumberIssuer":   true,
+	"IdentificationType":           true,
+	"PaymentNotificationIndicator": true,
+	"RemittanceLocationMethod":     true,
+	"SwiftFieldTag":                true,
+}
+
+// mask returns value redacted by m
+func (p RedactionPolicy) mask(m Mask, value string) string {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return value
+	}
+	switch m {
+	case MaskLastFour:
+		runes := []rune(trimmed)
+		if len(runes) <= 4 {
+			return strings.Repeat("*", len(runes))
+		}
+		return strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:])
+	case MaskHash:
+		var sum []byte
+		if len(p.HashKey) > 0 {
+			mac := hmac.New(sha256.New, p.HashKey)
+			mac.Write([]byte(trimmed))
+			sum = mac.Sum(nil)
+		} else {
+			digest := sha256.Sum256([]byte(trimmed))
+			sum = digest[:]
+		}
+		return "sha256:" + hex.EncodeToString(sum[:8])
+	case MaskHidden:
+		return "***"
+	}
+	return value
+}
+
+// fieldMask returns the mask of the party tag field called name
+func (p RedactionPolicy) fieldMask(name string) Mask {
+	switch {
+	case identifierFields[name]:
+		return p.Identifiers
+	case codeFields[name]:
+		return MaskNone
+	}
+	return p.PII
+}
+
+// Redact masks the identifiers and personal data in the party tags of fwm, such as Beneficiary,
+// Originator, OriginatorOptionF and OriginatorToBeneficiary, following policy
+func (fwm *FEDWireMessage) Redact(policy RedactionPolicy) {
+	fwm.mapSensitiveFields(func(sf reflect.StructField, _, value string) (string, error) {
+		return policy.mask(policy.fieldMask(sf.Name), value), nil
+	})
+}
+
+// Redacted returns a copy of f with its party tags redacted following policy. f is unchanged.
+func (f *File) Redacted(policy RedactionPolicy) *File {
+	out := f.copyParties()
+	out.FEDWireMessage.Redact(policy)
+	return out
+}
+
+// MapSensitiveFields returns a copy of f with each identifier and personal data field of its party tags
+// (the fields Redacted masks) replaced by the value fn returns. fn is called for non-empty values with
+// the JSON path of the field, e.g. beneficiary.personal.identifier. f is unchanged.
+func (f *File) MapSensitiveFields(fn func(path, value string) (string, error)) (*File, error) {
+	out := f.copyParties()
+	err := out.FEDWireMessage.mapSensitiveFields(func(_ reflect.StructField, path, value string) (string, error) {
+		return fn(path, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// copyParties returns a copy of f with its own party tags, which can be changed without changing f
+func (f *File) copyParties() *File {
+	out := *f
+	v := reflect.ValueOf(&out.FEDWireMessage).Elem()
+	for _, name := range partyTags {
+		if tag := v.FieldByName(name); !tag.IsNil() {
+			cp := reflect.New(tag.Type().Elem())
+			cp.Elem().Set(tag.Elem())
+			tag.Set(cp)
+		}
+	}
+	return &out
+}
+
+// mapSensitiveFields replaces the non-empty sensitive fields of the party tags of fwm with the values fn
+// returns, stopping at the first error
+func (fwm *FEDWireMessage) mapSensitiveFields(fn func(sf reflect.StructField, path, value string) (string, error)) error {
+	var err error
+	v := reflect.ValueOf(fwm).Elem()
+	for _, name := range partyTags {
+		tag := v.FieldByName(name)
+		if tag.IsNil() {
+			continue
+		}
+		sf, _ := v.Type().FieldByName(name)
+		walkStrings(tag.Elem(), jsonName(sf), nil, func(path string, _ []int, sf reflect.StructField, field reflect.Value) {
+			if err != nil || codeFields[sf.Name] || strings.TrimSpace(field.String()) == "" {
+				return
+			}
+			var value string
+			if value, err = fn(sf, path, field.String()); err == nil {
+				field.SetString(value)
+			}
+		})
+	}
+	return err
+}
+
+// MarshalRedactedJSON encodes f as JSON with its party tags redacted following policy
+func (f *File) MarshalRedactedJSON(policy RedactionPolicy) ([]byte, error) {
+	return json.Marshal(f.Redacted(policy))
+}
+
+var (
+	sensitiveFieldsOnce sync.Once
+	sensitiveFields     map[string]bool
+)
+
+// isSensitiveField reports if a field called name is redacted in any party tag
+func isSensitiveField(name string) bool {
+	sensitiveFieldsOnce.Do(func() {
+		sensitiveFields = make(map[string]bool)
+		fwm := reflect.TypeOf(FEDWireMessage{})
+		for _, tag := range partyTags {
+			sf, _ := fwm.FieldByName(tag)
+			walkStrings(reflect.New(sf.Type.Elem()).Elem(), "", nil, func(_ string, _ []int, sf reflect.StructField, _ reflect.Value) {
+				if !codeFields[sf.Name] {
+					sensitiveFields[sf.Name] = true
+				}
+			})
+		}
+	})
+	return sensitiveFields[name]
+}
+
+// RedactError returns err with the values of sensitive fields masked following policy, for errors
+// which are logged. Errors in a base.ErrorList or base.ParseError are redacted as well.
+func RedactError(err error, policy RedactionPolicy) error {
+	if list, ok := err.(base.ErrorList); ok {
+		out := make(base.ErrorList, len(list))
+		for i := range list {
+			out[i] = RedactError(list[i], policy)
+		}
+		return out
+	}
+	if pe, ok := err.(*base.ParseError); ok {
+		out := *pe
+		out.Err = RedactError(pe.Err, policy)
+		return &out
+	}
+	if fe, ok := err.(*FieldError); ok && fe.Value != nil && isSensitiveField(fe.FieldName) {
+		out := *fe
+		out.Value = policy.mask(policy.fieldMask(fe.FieldName), fmt.Sprint(fe.Value))
+		return &out
+	}
+	return err
+}
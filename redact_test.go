@@ -0,0 +1,134 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_Redacted(t *testing.T) {
+	file := mockValidatedFile()
+	fwm := &file.FEDWireMessage
+	fwm.Beneficiary.Personal.Identifier = "123456789"
+	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
+	fwm.OriginatorOptionF = mockOriginatorOptionF()
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
+
+	redacted := file.Redacted(DefaultRedactionPolicy)
+
+	ben := redacted.FEDWireMessage.Beneficiary.Personal
+	require.Equal(t, "*****6789", ben.Identifier)
+	require.Equal(t, DriversLicenseNumber, ben.IdentificationCode)
+	require.Equal(t, "***", ben.Name)
+	require.Equal(t, "***", ben.Address.AddressLineOne)
+
+	debitDD := redacted.FEDWireMessage.AccountDebitedDrawdown
+	require.Equal(t, "*****6789", debitDD.Identifier)
+	require.Equal(t, "***", debitDD.Name)
+
+	oof := redacted.FEDWireMessage.OriginatorOptionF
+	require.Equal(t, "************6789", oof.PartyIdentifier)
+	require.Equal(t, "***", oof.Name)
+	require.Equal(t, "***", oof.LineTwo)
+
+	// free text about the parties is hidden
+	require.Equal(t, "***", redacted.FEDWireMessage.OriginatorToBeneficiary.LineOne)
+	require.Equal(t, "***", redacted.FEDWireMessage.UnstructuredAddenda.Addenda)
+	require.Equal(t, "0020", redacted.FEDWireMessage.UnstructuredAddenda.AddendaLength)
+
+	// financial institutions aren't parties
+	require.Equal(t, "123456789", redacted.FEDWireMessage.BeneficiaryFI.FinancialInstitution.Identifier)
+
+	// the original is unchanged
+	require.Equal(t, "123456789", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "Name", fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "TXID/123-45-6789", fwm.OriginatorOptionF.PartyIdentifier)
+
+	bs, err := file.MarshalRedactedJSON(DefaultRedactionPolicy)
+	require.NoError(t, err)
+	require.NotContains(t, string(bs), "debitDD Name")
+	require.NotContains(t, string(bs), "Colonial Farm")
+	require.Contains(t, string(bs), "*****6789")
+}
+
+func TestRedactionPolicy_mask(t *testing.T) {
+	policy := RedactionPolicy{}
+	require.Equal(t, "123456789", policy.mask(MaskNone, "123456789"))
+	require.Equal(t, "*****6789", policy.mask(MaskLastFour, "123456789 "))
+	require.Equal(t, "****", policy.mask(MaskLastFour, "1234"))
+	require.Equal(t, "***", policy.mask(MaskHidden, "Name"))
+	require.Equal(t, "", policy.mask(MaskHidden, ""))
+
+	hash := policy.mask(MaskHash, "123456789")
+	require.True(t, strings.HasPrefix(hash, "sha256:"))
+	require.Equal(t, hash, policy.mask(MaskHash, "123456789"))
+	require.NotEqual(t, hash, policy.mask(MaskHash, "123456780"))
+
+	keyed := RedactionPolicy{HashKey: []byte("secret")}
+	require.NotEqual(t, hash, keyed.mask(MaskHash, "123456789"))
+}
+
+func TestFEDWireMessage_RedactHash(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.Originator.Personal.Identifier = fwm.Beneficiary.Personal.Identifier
+
+	fwm.Redact(RedactionPolicy{Identifiers: MaskHash, PII: MaskNone})
+	require.Equal(t, fwm.Beneficiary.Personal.Identifier, fwm.Originator.Personal.Identifier)
+	require.True(t, strings.HasPrefix(fwm.Beneficiary.Personal.Identifier, "sha256:"))
+	require.Equal(t, "Name", fwm.Beneficiary.Personal.Name)
+
+	bs, err := json.Marshal(fwm.Beneficiary)
+	require.NoError(t, err)
+	require.NotContains(t, string(bs), `"1234"`)
+}
+
+func TestRedactError(t *testing.T) {
+	err := fieldError("Identifier", ErrNonAlphanumeric, "12345678®")
+	require.Equal(t, "Identifier *****678® has non alphanumeric characters", RedactError(err, DefaultRedactionPolicy).Error())
+
+	err = fieldError("Name", ErrNonAlphanumeric, "Zoë")
+	require.Equal(t, "Name *** has non alphanumeric characters", RedactError(err, DefaultRedactionPolicy).Error())
+
+	// codes aren't sensitive
+	err = fieldError("IdentificationCode", ErrIdentificationCode, "Z")
+	require.Equal(t, err, RedactError(err, DefaultRedactionPolicy))
+
+	var list base.ErrorList
+	list.Add(&base.ParseError{Line: 1, Record: TagBeneficiary, Err: fieldError("Identifier", ErrNonAlphanumeric, "12345678®")})
+	redacted := RedactError(list, DefaultRedactionPolicy)
+	require.Contains(t, redacted.Error(), "*****678®")
+	require.NotContains(t, redacted.Error(), "12345678")
+	require.Contains(t, list.Error(), "12345678®")
+}
+
+func TestFile_MapSensitiveFields(t *testing.T) {
+	file := mockValidatedFile()
+
+	var paths []string
+	out, err := file.MapSensitiveFields(func(path, value string) (string, error) {
+		paths = append(paths, path)
+		return strings.ToLower(value), nil
+	})
+	require.NoError(t, err)
+	require.Contains(t, paths, "beneficiary.personal.identifier")
+	require.Contains(t, paths, "originator.personal.address.addressLineOne")
+	require.NotContains(t, paths, "beneficiary.personal.identificationCode")
+	require.Equal(t, "address one", out.FEDWireMessage.Beneficiary.Personal.Address.AddressLineOne)
+	require.Equal(t, "Address One", file.FEDWireMessage.Beneficiary.Personal.Address.AddressLineOne)
+
+	_, err = file.MapSensitiveFields(func(path, value string) (string, error) {
+		return "", ErrFieldRequired
+	})
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
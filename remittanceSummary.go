@@ -0,0 +1,201 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RemittanceSummary totals the structured remittance amounts ({8450} through {8600}) of a FEDWireMessage
+type RemittanceSummary struct {
+	// Documents holds one entry per remittance document. Fedwire carries a single
+	// PrimaryRemittanceDocument per message.
+	Documents []RemittanceDocumentSummary `json:"documents"`
+}
+
+// RemittanceDocumentSummary is the net amount of one remittance document. Amounts use a period as
+// the decimal marker and keep the precision of the message (at least two decimal places).
+type RemittanceDocumentSummary struct {
+	// DocumentTypeCode from PrimaryRemittanceDocument
+	DocumentTypeCode string `json:"documentTypeCode,omitempty"`
+	// ProprietaryDocumentTypeCode from PrimaryRemittanceDocument
+	ProprietaryDocumentTypeCode string `json:"proprietaryDocumentTypeCode,omitempty"`
+	// DocumentIdentificationNumber from PrimaryRemittanceDocument
+	DocumentIdentificationNumber string `json:"documentIdentificationNumber,omitempty"`
+	// CurrencyCode shared by every remittance amount
+	CurrencyCode string `json:"currencyCode"`
+	// GrossAmount is the GrossAmountRemittanceDocument amount
+	GrossAmount string `json:"grossAmount,omitempty"`
+	// DiscountAmount is the AmountNegotiatedDiscount amount
+	DiscountAmount string `json:"discountAmount"`
+	// AdjustmentAmount is the signed Adjustment amount. Credits are negative.
+	AdjustmentAmount string `json:"adjustmentAmount"`
+	// NetAmount is GrossAmount less DiscountAmount plus AdjustmentAmount, or ActualAmountPaid
+	// when the message has no GrossAmountRemittanceDocument
+	NetAmount string `json:"netAmount"`
+	// ActualAmountPaid is the ActualAmountPaid amount
+	ActualAmountPaid string `json:"actualAmountPaid,omitempty"`
+}
+
+// RemittanceSummary returns the net amount of each remittance document in fwm. A nil summary is
+// returned when fwm has no structured remittance amounts.
+//
+// The net amount is GrossAmountRemittanceDocument less AmountNegotiatedDiscount, less an Adjustment
+// with a CreditDebitIndicator of CRDT or plus one of DBIT.
+func (fwm *FEDWireMessage) RemittanceSummary() (*RemittanceSummary, error) {
+	if fwm.ActualAmountPaid == nil && fwm.GrossAmountRemittanceDocument == nil {
+		return nil, nil
+	}
+
+	doc := RemittanceDocumentSummary{}
+	if prd := fwm.PrimaryRemittanceDocument; prd != nil {
+		doc.DocumentTypeCode = prd.DocumentTypeCode
+		doc.ProprietaryDocumentTypeCode = prd.ProprietaryDocumentTypeCode
+		doc.DocumentIdentificationNumber = prd.DocumentIdentificationNumber
+	}
+
+	scale := 2
+	amount := func(field string, ra RemittanceAmount) (*big.Rat, error) {
+		if doc.CurrencyCode == "" {
+			doc.CurrencyCode = ra.CurrencyCode
+		} else if ra.CurrencyCode != doc.CurrencyCode {
+			return nil, fieldError(field, ErrRemittanceCurrency, ra.CurrencyCode)
+		}
+		r, s, err := parseDecimalAmount(ra.Amount, '.')
+		if err != nil {
+			return nil, fieldError(field, err, ra.Amount)
+		}
+		if s > scale {
+			scale = s
+		}
+		return r, nil
+	}
+
+	var gross, discount, adjustment, paid *big.Rat
+	var err error
+	if fwm.ActualAmountPaid != nil {
+		if paid, err = amount("ActualAmountPaid", fwm.ActualAmountPaid.RemittanceAmount); err != nil {
+			return nil, err
+		}
+	}
+	if fwm.GrossAmountRemittanceDocument != nil {
+		if gross, err = amount("GrossAmountRemittanceDocument", fwm.GrossAmountRemittanceDocument.RemittanceAmount); err != nil {
+			return nil, err
+		}
+	}
+	discount = new(big.Rat)
+	if fwm.AmountNegotiatedDiscount != nil {
+		if discount, err = amount("AmountNegotiatedDiscount", fwm.AmountNegotiatedDiscount.RemittanceAmount); err != nil {
+			return nil, err
+		}
+	}
+	adjustment = new(big.Rat)
+	if adj := fwm.Adjustment; adj != nil {
+		if adjustment, err = amount("Adjustment", adj.RemittanceAmount); err != nil {
+			return nil, err
+		}
+		switch adj.CreditDebitIndicator {
+		case CreditIndicator:
+			adjustment.Neg(adjustment)
+		case DebitIndicator:
+		default:
+			return nil, fieldError("CreditDebitIndicator", ErrCreditDebitIndicator, adj.CreditDebitIndicator)
+		}
+	}
+
+	net := paid
+	if gross != nil {
+		net = new(big.Rat).Sub(gross, discount)
+		net.Add(net, adjustment)
+		doc.GrossAmount = gross.FloatString(scale)
+	}
+
+	doc.DiscountAmount = discount.FloatString(scale)
+	doc.AdjustmentAmount = adjustment.FloatString(scale)
+	doc.NetAmount = net.FloatString(scale)
+	if paid != nil {
+		doc.ActualAmountPaid = paid.FloatString(scale)
+	}
+
+	return &RemittanceSummary{
+		Documents: []RemittanceDocumentSummary{doc},
+	}, nil
+}
+
+// reconcileRemittance checks that the structured remittance amounts add up. The net amount of the
+// document must equal ActualAmountPaid, which in turn must equal InstructedAmount when it's in the same
+// currency, or Amount when paid in USD.
+func (fwm *FEDWireMessage) reconcileRemittance() error {
+	if fwm.ValidateOptions != nil && fwm.ValidateOptions.SkipRemittanceReconciliation {
+		return nil
+	}
+
+	summary, err := fwm.RemittanceSummary()
+	if err != nil || summary == nil {
+		return err
+	}
+	if fwm.ActualAmountPaid == nil {
+		return nil
+	}
+
+	for _, doc := range summary.Documents {
+		if doc.GrossAmount != "" && doc.NetAmount != doc.ActualAmountPaid {
+			return fieldError("ActualAmountPaid", ErrRemittanceNetAmount,
+				fmt.Sprintf("%s (net amount %s)", fwm.ActualAmountPaid.RemittanceAmount.Amount, doc.NetAmount))
+		}
+
+		paid, _, _ := parseDecimalAmount(doc.ActualAmountPaid, '.')
+		switch {
+		case fwm.InstructedAmount != nil && fwm.InstructedAmount.CurrencyCode == doc.CurrencyCode:
+			instructed, _, err := parseDecimalAmount(fwm.InstructedAmount.Amount, ',')
+			if err != nil {
+				return fieldError("InstructedAmount", err, fwm.InstructedAmount.Amount)
+			}
+			if paid.Cmp(instructed) != 0 {
+				return fieldError("ActualAmountPaid", ErrRemittanceTransferAmount,
+					fmt.Sprintf("%s (instructed amount %s)", fwm.ActualAmountPaid.RemittanceAmount.Amount, fwm.InstructedAmount.Amount))
+			}
+
+		case doc.CurrencyCode == "USD" && fwm.Amount != nil:
+			cents, ok := new(big.Int).SetString(fwm.Amount.Amount, 10)
+			if !ok {
+				return fieldError("Amount", ErrNonAmount, fwm.Amount.Amount)
+			}
+			if paid.Cmp(new(big.Rat).SetFrac(cents, big.NewInt(100))) != 0 {
+				return fieldError("ActualAmountPaid", ErrRemittanceTransferAmount,
+					fmt.Sprintf("%s (amount %s)", fwm.ActualAmountPaid.RemittanceAmount.Amount, fwm.Amount.Amount))
+			}
+		}
+	}
+	return nil
+}
+
+// parseDecimalAmount parses s with the given decimal marker, returning the number of decimal places.
+// The other marker is treated as a thousands separator.
+func parseDecimalAmount(s string, decimal rune) (*big.Rat, int, error) {
+	separator := ","
+	if decimal == ',' {
+		separator = "."
+	}
+	s = strings.ReplaceAll(strings.TrimSpace(s), separator, "")
+	if decimal == ',' {
+		s = strings.Replace(s, ",", ".", 1)
+	}
+
+	scale := 0
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		scale = len(s) - i - 1
+	}
+	if s == "" || strings.ContainsAny(s, "+-eE/") {
+		return nil, 0, ErrNonAmount
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, 0, ErrNonAmount
+	}
+	return r, scale, nil
+}
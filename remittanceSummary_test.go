@@ -0,0 +1,132 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockRemittanceAmounts returns a message whose remittance amounts add up to mockAmount
+func mockRemittanceAmounts() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
+	fwm.ActualAmountPaid.RemittanceAmount.Amount = "12345.67"
+	fwm.GrossAmountRemittanceDocument = mockGrossAmountRemittanceDocument()
+	fwm.GrossAmountRemittanceDocument.RemittanceAmount.Amount = "12500.00"
+	fwm.AmountNegotiatedDiscount = mockAmountNegotiatedDiscount()
+	fwm.AmountNegotiatedDiscount.RemittanceAmount.Amount = "100"
+	fwm.Adjustment = mockAdjustment()
+	fwm.Adjustment.RemittanceAmount.Amount = "54.33"
+	return fwm
+}
+
+func TestFEDWireMessage_RemittanceSummary(t *testing.T) {
+	fwm := mockRemittanceAmounts()
+
+	summary, err := fwm.RemittanceSummary()
+	require.NoError(t, err)
+	require.Equal(t, &RemittanceSummary{
+		Documents: []RemittanceDocumentSummary{
+			{
+				DocumentTypeCode:             fwm.PrimaryRemittanceDocument.DocumentTypeCode,
+				DocumentIdentificationNumber: fwm.PrimaryRemittanceDocument.DocumentIdentificationNumber,
+				CurrencyCode:                 "USD",
+				GrossAmount:                  "12500.00",
+				DiscountAmount:               "100.00",
+				AdjustmentAmount:             "-54.33",
+				NetAmount:                    "12345.67",
+				ActualAmountPaid:             "12345.67",
+			},
+		},
+	}, summary)
+
+	// debits add to the amount owed and precision is kept
+	fwm.Adjustment.CreditDebitIndicator = DebitIndicator
+	fwm.Adjustment.RemittanceAmount.Amount = "0.001"
+	summary, err = fwm.RemittanceSummary()
+	require.NoError(t, err)
+	require.Equal(t, "0.001", summary.Documents[0].AdjustmentAmount)
+	require.Equal(t, "12400.001", summary.Documents[0].NetAmount)
+
+	// without a gross amount the net amount is what was paid
+	fwm.GrossAmountRemittanceDocument = nil
+	summary, err = fwm.RemittanceSummary()
+	require.NoError(t, err)
+	require.Empty(t, summary.Documents[0].GrossAmount)
+	require.Equal(t, "12345.670", summary.Documents[0].NetAmount)
+
+	fwm = mockCustomerTransferData()
+	summary, err = fwm.RemittanceSummary()
+	require.NoError(t, err)
+	require.Nil(t, summary)
+}
+
+func TestFEDWireMessage_RemittanceSummaryCurrency(t *testing.T) {
+	fwm := mockRemittanceAmounts()
+	fwm.AmountNegotiatedDiscount.RemittanceAmount.CurrencyCode = "EUR"
+
+	_, err := fwm.RemittanceSummary()
+	require.EqualError(t, err, fieldError("AmountNegotiatedDiscount", ErrRemittanceCurrency, "EUR").Error())
+}
+
+func TestFEDWireMessage_reconcileRemittance(t *testing.T) {
+	fwm := mockRemittanceAmounts()
+	require.NoError(t, fwm.reconcileRemittance())
+
+	fwm.AmountNegotiatedDiscount.RemittanceAmount.Amount = "99.99"
+	err := fwm.reconcileRemittance()
+	require.ErrorIs(t, err, ErrRemittanceNetAmount)
+	require.Contains(t, err.Error(), "net amount 12345.68")
+
+	fwm.ValidateOptions = &ValidateOpts{SkipRemittanceReconciliation: true}
+	require.NoError(t, fwm.reconcileRemittance())
+}
+
+func TestFEDWireMessage_reconcileRemittanceTransferAmount(t *testing.T) {
+	fwm := mockRemittanceAmounts()
+	fwm.Amount.Amount = "000001234568"
+	require.ErrorIs(t, fwm.reconcileRemittance(), ErrRemittanceTransferAmount)
+
+	// InstructedAmount is used when it's in the remittance currency
+	fwm.InstructedAmount = mockInstructedAmount()
+	fwm.InstructedAmount.Amount = "12345,67"
+	require.NoError(t, fwm.reconcileRemittance())
+
+	fwm.InstructedAmount.Amount = "12000,00"
+	require.ErrorIs(t, fwm.reconcileRemittance(), ErrRemittanceTransferAmount)
+
+	// amounts in other currencies can't be compared with the transfer
+	fwm = mockRemittanceAmounts()
+	for _, ra := range []*RemittanceAmount{
+		&fwm.ActualAmountPaid.RemittanceAmount,
+		&fwm.GrossAmountRemittanceDocument.RemittanceAmount,
+		&fwm.AmountNegotiatedDiscount.RemittanceAmount,
+		&fwm.Adjustment.RemittanceAmount,
+	} {
+		ra.CurrencyCode = "EUR"
+	}
+	fwm.Amount.Amount = "000000000100"
+	require.NoError(t, fwm.reconcileRemittance())
+}
+
+func TestParseDecimalAmount(t *testing.T) {
+	r, scale, err := parseDecimalAmount("1,234.5", '.')
+	require.NoError(t, err)
+	require.Equal(t, "1234.5", r.FloatString(1))
+	require.Equal(t, 1, scale)
+
+	r, scale, err = parseDecimalAmount("1234,56", ',')
+	require.NoError(t, err)
+	require.Equal(t, "1234.56", r.FloatString(2))
+	require.Equal(t, 2, scale)
+
+	for _, s := range []string{"", "-1", "1e5", "abc"} {
+		_, _, err = parseDecimalAmount(s, '.')
+		require.ErrorIs(t, err, ErrNonAmount, s)
+	}
+}
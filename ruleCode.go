@@ -0,0 +1,257 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
+)
+
+// tagNumbers are the tag numbers of the fields of FEDWireMessage
+var tagNumbers = map[string]string{
+	"MessageDisposition":              TagMessageDisposition,
+	"ReceiptTimeStamp":                TagReceiptTimeStamp,
+	"OutputMessageAccountabilityData": TagOutputMessageAccountabilityData,
+	"ErrorWire":                       TagErrorWire,
+	"SenderSupplied":                  TagSenderSupplied,
+	"TypeSubType":                     TagTypeSubType,
+	"InputMessageAccountabilityData":  TagInputMessageAccountabilityData,
+	"Amount":                          TagAmount,
+	"SenderDepositoryInstitution":     TagSenderDepositoryInstitution,
+	"ReceiverDepositoryInstitution":   TagReceiverDepositoryInstitution,
+	"BusinessFunctionCode":            TagBusinessFunctionCode,
+	"SenderReference":                 TagSenderReference,
+	"PreviousMessageIdentifier":       TagPreviousMessageIdentifier,
+	"LocalInstrument":                 TagLocalInstrument,
+	"PaymentNotification":             TagPaymentNotification,
+	"Charges":                         TagCharges,
+	"InstructedAmount":                TagInstructedAmount,
+	"ExchangeRate":                    TagExchangeRate,
+	"BeneficiaryIntermediaryFI":       TagBeneficiaryIntermediaryFI,
+	"BeneficiaryFI":                   TagBeneficiaryFI,
+	"Beneficiary":                     TagBeneficiary,
+	"BeneficiaryReference":            TagBeneficiaryReference,
+	"AccountDebitedDrawdown":          TagAccountDebitedDrawdown,
+	"Originator":                      TagOriginator,
+	"OriginatorOptionF":               TagOriginatorOptionF,
+	"OriginatorFI":                    TagOriginatorFI,
+	"InstructingFI":                   TagInstructingFI,
+	"AccountCreditedDrawdown":         TagAccountCreditedDrawdown,
+	"OriginatorToBeneficiary":         TagOriginatorToBeneficiary,
+	"FIReceiverFI":                    TagFIReceiverFI,
+	"FIDrawdownDebitAccountAdvice":    TagFIDrawdownDebitAccountAdvice,
+	"FIIntermediaryFI":                TagFIIntermediaryFI,
+	"FIIntermediaryFIAdvice":          TagFIIntermediaryFIAdvice,
+	"FIBeneficiaryFI":                 TagFIBeneficiaryFI,
+	"FIBeneficiaryFIAdvice":           TagFIBeneficiaryFIAdvice,
+	"FIBeneficiary":                   TagFIBeneficiary,
+	"FIBeneficiaryAdvice":             TagFIBeneficiaryAdvice,
+	"FIPaymentMethodToBeneficiary":    TagFIPaymentMethodToBeneficiary,
+	"FIAdditionalFIToFI":              TagFIAdditionalFIToFI,
+	"CurrencyInstructedAmount":        TagCurrencyInstructedAmount,
+	"OrderingCustomer":                TagOrderingCustomer,
+	"OrderingInstitution":             TagOrderingInstitution,
+	"IntermediaryInstitution":         TagIntermediaryInstitution,
+	"InstitutionAccount":              TagInstitutionAccount,
+	"BeneficiaryCustomer":             TagBeneficiaryCustomer,
+	"Remittance":                      TagRemittance,
+	"SenderToReceiver":                TagSenderToReceiver,
+	"UnstructuredAddenda":             TagUnstructuredAddenda,
+	"RelatedRemittance":               TagRelatedRemittance,
+	"RemittanceOriginator":            TagRemittanceOriginator,
+	"RemittanceBeneficiary":           TagRemittanceBeneficiary,
+	"PrimaryRemittanceDocument":       TagPrimaryRemittanceDocument,
+	"ActualAmountPaid":                TagActualAmountPaid,
+	"GrossAmountRemittanceDocument":   TagGrossAmountRemittanceDocument,
+	"AmountNegotiatedDiscount":        TagAmountNegotiatedDiscount,
+	"Adjustment":                      TagAdjustment,
+	"DateRemittanceDocument":          TagDateRemittanceDocument,
+	"SecondaryRemittanceDocument":     TagSecondaryRemittanceDocument,
+	"RemittanceFreeText":              TagRemittanceFreeText,
+	"ServiceMessage":                  TagServiceMessage,
+}
+
+// numberWords are the words abbreviated to a number in rule codes, so AddressLineTwo and AddressLineThree differ
+var numberWords = map[string]string{
+	"One": "1", "Two": "2", "Three": "3", "Four": "4", "Five": "5", "Six": "6",
+	"Seven": "7", "Eight": "8", "Nine": "9", "Ten": "10", "Eleven": "11", "Twelve": "12",
+}
+
+// fieldAbbreviations are the fields whose initials are those of another field in the same tag
+var fieldAbbreviations = map[string]string{
+	"errorWire.errorCategory": "ECAT",
+}
+
+// RuleCode returns the stable code of a rule broken by a field, e.g. W-3600-BFC-INVALID for an invalid
+// businessFunctionCode.businessFunctionCode in tag {3600}. The code is made of the tag number, the
+// initials of the field (TAG for the tag itself) and the rule, and doesn't change with the wording of
+// messages.
+func RuleCode(tag, path, rule string) string {
+	parts := []string{"W"}
+	if number := strings.Trim(tag, "{}"); number != "" {
+		parts = append(parts, number)
+	}
+	if initials := fieldInitials(path); path != "" && initials != "" {
+		parts = append(parts, initials)
+	}
+	return strings.Join(append(parts, rule), "-")
+}
+
+// fieldInitials abbreviates the last field of path to its initials, e.g. BFC for businessFunctionCode,
+// unless it's one of fieldAbbreviations. A path naming only a tag is abbreviated to TAG, and a field
+// with an empty name to nothing.
+func fieldInitials(path string) string {
+	i := strings.LastIndex(path, ".")
+	if i < 0 {
+		return "TAG"
+	}
+	if abbreviation, ok := fieldAbbreviations[path]; ok {
+		return abbreviation
+	}
+	var initials strings.Builder
+	for _, word := range camelWords(path[i+1:]) {
+		if word == "" {
+			continue
+		}
+		if digit, ok := numberWords[word]; ok {
+			initials.WriteString(digit)
+		} else {
+			first, _ := utf8.DecodeRuneInString(word)
+			initials.WriteRune(unicode.ToUpper(first))
+		}
+	}
+	return initials.String()
+}
+
+// camelWords splits a camel case name into words, keeping runs of capitals such as ABA or FI together
+func camelWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		upper := unicode.IsUpper(runes[i])
+		prevUpper := unicode.IsUpper(runes[i-1])
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if upper && (!prevUpper || nextLower) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(words, string(runes[start:]))
+}
+
+// locate returns the tag number and JSON path within fwm of the field err is about. Errors from a tag's
+// Validate are found by validating the tags of fwm again, errors from Reader by the tag being read and
+// other errors by the name of the field.
+func (fwm *FEDWireMessage) locate(err error) (tag, path string) {
+	var name string
+	var fe *FieldError
+	var bfc ErrBusinessFunctionCodeProperty
+	var prop ErrInvalidPropertyForProperty
+	switch {
+	case errors.As(err, &fe):
+		name = fe.FieldName
+	case errors.As(err, &bfc):
+		name = bfc.Property
+	case errors.As(err, &prop):
+		name = prop.Property
+	}
+
+	v := reflect.ValueOf(fwm).Elem()
+	var pe *base.ParseError
+	if errors.As(err, &pe) && tagNumbers[pe.Record] != "" {
+		sf, _ := v.Type().FieldByName(pe.Record)
+		if name == "" || name == pe.Record {
+			// errors about the tag read, such as its length
+			return tagNumbers[pe.Record], jsonName(sf)
+		}
+		if path := fieldPath(reflect.New(sf.Type.Elem()).Elem(), jsonName(sf), name); path != "" {
+			return tagNumbers[pe.Record], path
+		}
+		return tagNumbers[pe.Record], jsonName(sf) + "." + lowerFirst(name)
+	}
+	if name == "" {
+		return "", ""
+	}
+	if tag := pathTag(name); tag != "" && strings.Contains(name, ".") {
+		// errors naming the JSON path of their field, such as from NormalizationOverflow
+		return tag, name
+	}
+	if fe != nil {
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if field.Kind() != reflect.Ptr || field.IsNil() {
+				continue
+			}
+			validator, ok := field.Interface().(interface{ Validate() error })
+			if !ok {
+				continue
+			}
+			var found *FieldError
+			if errors.As(validator.Validate(), &found) && found.FieldName == fe.FieldName && found.Err == fe.Err {
+				sf := v.Type().Field(i)
+				if path := fieldPath(field.Elem(), jsonName(sf), name); path != "" {
+					return tagNumbers[sf.Name], path
+				}
+				return tagNumbers[sf.Name], jsonName(sf) + "." + lowerFirst(name)
+			}
+		}
+	}
+
+	// errors about a whole tag, or a field of a tag found by checks across tags
+	if sf, ok := v.Type().FieldByName(name); ok && tagNumbers[name] != "" {
+		return tagNumbers[name], jsonName(sf)
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Ptr || field.IsNil() || field.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		sf := v.Type().Field(i)
+		if path := fieldPath(field.Elem(), jsonName(sf), name); path != "" {
+			return tagNumbers[sf.Name], path
+		}
+	}
+	return "", lowerFirst(name)
+}
+
+// fieldPath returns the JSON path of the first string field called name within v, a tag struct,
+// or an empty string when v has none
+func fieldPath(v reflect.Value, path, name string) string {
+	var found string
+	walkStrings(v, path, nil, func(fieldPath string, _ []int, sf reflect.StructField, _ reflect.Value) {
+		if found == "" && sf.Name == name {
+			found = fieldPath
+		}
+	})
+	return found
+}
+
+// lowerFirst returns name with its first letter in lowercase
+func lowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+// pathTag returns the tag number of the FEDWireMessage field path starts with
+func pathTag(path string) string {
+	name, _, _ := strings.Cut(path, ".")
+	fwm := reflect.TypeOf(FEDWireMessage{})
+	for i := 0; i < fwm.NumField(); i++ {
+		if jsonName(fwm.Field(i)) == name {
+			return tagNumbers[fwm.Field(i).Name]
+		}
+	}
+	return ""
+}
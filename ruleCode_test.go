@@ -0,0 +1,72 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleCode(t *testing.T) {
+	require.Equal(t, "W-3600-BFC-INVALID", RuleCode(TagBusinessFunctionCode, "businessFunctionCode.businessFunctionCode", RuleInvalid))
+	require.Equal(t, "W-3100-SAN-NUMERIC", RuleCode(TagSenderDepositoryInstitution, "senderDepositoryInstitution.senderABANumber", RuleNumeric))
+	require.Equal(t, "W-4200-AL2-CHARSET", RuleCode(TagBeneficiary, "beneficiary.personal.address.addressLineTwo", RuleCharset))
+	require.Equal(t, "W-4200-AL3-CHARSET", RuleCode(TagBeneficiary, "beneficiary.personal.address.addressLineThree", RuleCharset))
+	require.Equal(t, "W-4100-TAG-REQUIRED", RuleCode(TagBeneficiaryFI, "beneficiaryFI", RuleRequired))
+	require.Equal(t, "W-INVALID", RuleCode("", "", RuleInvalid))
+	require.Equal(t, "W-9000-L12-CHARSET", RuleCode(TagServiceMessage, "serviceMessage.lineTwelve", RuleCharset))
+	require.Equal(t, "W-1130-ECAT-INVALID", RuleCode(TagErrorWire, "errorWire.errorCategory", RuleInvalid))
+	require.Equal(t, "W-2000-ÜN-INVALID", RuleCode(TagAmount, "amount.ünïcodeName", RuleInvalid))
+	require.Equal(t, "W-2000-INVALID", RuleCode(TagAmount, "amount.", RuleInvalid))
+}
+
+func TestFieldInitials_unique(t *testing.T) {
+	fwm := reflect.TypeOf(FEDWireMessage{})
+	for i := 0; i < fwm.NumField(); i++ {
+		sf := fwm.Field(i)
+		if sf.Type.Kind() != reflect.Ptr || sf.Type.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		paths := make(map[string]string)
+		walkStrings(reflect.New(sf.Type.Elem()).Elem(), jsonName(sf), nil, func(path string, _ []int, _ reflect.StructField, _ reflect.Value) {
+			initials := fieldInitials(path)
+			require.NotContains(t, paths, initials, "%s and %s are both %s", paths[initials], path, initials)
+			paths[initials] = path
+		})
+	}
+}
+
+func TestFEDWireMessage_locate(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+
+	// a field of a tag
+	fwm.BusinessFunctionCode.BusinessFunctionCode = "ZZZ"
+	tag, path := fwm.locate(fwm.BusinessFunctionCode.Validate())
+	require.Equal(t, TagBusinessFunctionCode, tag)
+	require.Equal(t, "businessFunctionCode.businessFunctionCode", path)
+
+	// a field nested within a tag
+	fwm.Beneficiary.Personal.Address.AddressLineTwo = "®"
+	tag, path = fwm.locate(fwm.Beneficiary.Validate())
+	require.Equal(t, TagBeneficiary, tag)
+	require.Equal(t, "beneficiary.personal.address.addressLineTwo", path)
+
+	// a missing tag
+	tag, path = fwm.locate(fieldError("OriginatorFI", ErrFieldRequired))
+	require.Equal(t, TagOriginatorFI, tag)
+	require.Equal(t, "originatorFI", path)
+
+	// a tag not permitted with the business function code
+	tag, path = fwm.locate(NewErrBusinessFunctionCodeProperty("LocalInstrument", "ANSI", CustomerTransfer))
+	require.Equal(t, TagLocalInstrument, tag)
+	require.Equal(t, "localInstrument", path)
+
+	tag, path = fwm.locate(ErrNonNumeric)
+	require.Empty(t, tag)
+	require.Empty(t, path)
+}
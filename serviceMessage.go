@@ -381,3 +381,22 @@ func (sm *ServiceMessage) FormatLineEleven(options FormatOptions) string {
 func (sm *ServiceMessage) FormatLineTwelve(options FormatOptions) string {
 	return sm.formatAlphaField(sm.LineTwelve, 35, options)
 }
+
+// SetText word-wraps text across the twelve lines of ServiceMessage. ServiceMessage is left unchanged
+// when text doesn't fit or has characters outside the wire character set.
+func (sm *ServiceMessage) SetText(text string) error {
+	lines, err := WrapText(text, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35)
+	if err != nil {
+		return err
+	}
+	sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour = lines[0], lines[1], lines[2], lines[3]
+	sm.LineFive, sm.LineSix, sm.LineSeven, sm.LineEight = lines[4], lines[5], lines[6], lines[7]
+	sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve = lines[8], lines[9], lines[10], lines[11]
+	return nil
+}
+
+// Text joins the lines of ServiceMessage into a single paragraph
+func (sm *ServiceMessage) Text() string {
+	return JoinText(sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour, sm.LineFive, sm.LineSix,
+		sm.LineSeven, sm.LineEight, sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve)
+}
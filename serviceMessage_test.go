@@ -401,3 +401,17 @@ func TestStringServiceMessageOptions(t *testing.T) {
 	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{9000}A*")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestServiceMessageText validates ServiceMessage text wraps across its lines
+func TestServiceMessageText(t *testing.T) {
+	sm := NewServiceMessage()
+	text := strings.Repeat("Service message text ", 10)
+	require.NoError(t, sm.SetText(text))
+	require.Equal(t, "Service message text Service", sm.LineOne)
+	require.Equal(t, strings.TrimSpace(text), sm.Text())
+	require.NoError(t, sm.Validate())
+
+	err := sm.SetText(strings.Repeat("Service message text ", 30))
+	require.ErrorIs(t, err, ErrTextOverflow)
+	require.Equal(t, "Service message text Service", sm.LineOne)
+}
@@ -0,0 +1,211 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// Rule codes of the errors returned by FileFromJSONStrict
+const (
+	// RuleUnknownField is a JSON key which isn't a field of the tag, including keys whose case differs.
+	// Its code names only the tag, e.g. W-3500-UNKNOWN-FIELD.
+	RuleUnknownField = "UNKNOWN-FIELD"
+	// RuleType is a JSON value of the wrong type, such as a number for a text field
+	RuleType = "TYPE"
+)
+
+// JSONFieldError is a key or value in the JSON of a File which FileFromJSONStrict rejects
+type JSONFieldError struct {
+	// Pointer is the JSON pointer (RFC 6901) of the key or value within the File, e.g.
+	// /fedWireMessage/localInstrument/LocalInstrumentCode
+	Pointer string
+	// Rule is RuleUnknownField or RuleType
+	Rule string
+	Msg  string
+}
+
+func (e *JSONFieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pointer, e.Msg)
+}
+
+// issue describes e as a ValidationIssue
+func (e *JSONFieldError) issue() ValidationIssue {
+	var field string
+	if path, ok := strings.CutPrefix(e.Pointer, "/fedWireMessage/"); ok {
+		parts := strings.Split(path, "/")
+		for i := range parts {
+			parts[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(parts[i])
+		}
+		field = strings.Join(parts, ".")
+	}
+	tag := pathTag(field)
+	code := RuleCode(tag, field, e.Rule)
+	if e.Rule == RuleUnknownField {
+		// unknown keys are chosen by whoever sent the JSON, so they're left out of the code
+		code = RuleCode(tag, "", e.Rule)
+	}
+	return ValidationIssue{
+		Code:    code,
+		Tag:     tag,
+		Field:   field,
+		Pointer: e.Pointer,
+		Rule:    e.Rule,
+		Message: e.Error(),
+		err:     e,
+	}
+}
+
+// FileFromJSONStrict is like FileFromJSON but rejects JSON which FileFromJSON silently ignores or
+// guesses at: keys which aren't fields of a tag (such as LocalInstrumentCode rather than LocalInstrument),
+// keys whose case differs from the field's (such as previousMessageIdentifier rather than
+// PreviousMessageIdentifier), values of the wrong type and null for anything but an optional tag.
+//
+// Keys are those read by the UnmarshalJSON method of each tag, matched exactly like the JSON Schema of
+// wirepb does. Every key and value rejected is returned as a *JSONFieldError in a base.ErrorList, in the
+// order they appear.
+func FileFromJSONStrict(bs []byte) (*File, error) {
+	d := &strictDecoder{dec: json.NewDecoder(bytes.NewReader(bs))}
+	if err := d.value(reflect.TypeOf(File{}), "", false); err != nil {
+		return nil, fmt.Errorf("problem reading File: %v", err)
+	}
+	if _, err := d.dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("problem reading File: unexpected data after the File")
+	}
+	if !d.errors.Empty() {
+		return nil, d.errors
+	}
+	return FileFromJSON(bs)
+}
+
+// strictDecoder reads JSON token by token to check it against the structs it's decoded into
+type strictDecoder struct {
+	dec    *json.Decoder
+	errors base.ErrorList
+}
+
+// value checks the next value is of type t, and records a JSONFieldError at pointer when it's not.
+// Only errors reading the JSON itself are returned.
+func (d *strictDecoder) value(t reflect.Type, pointer string, nullable bool) error {
+	if t.Kind() == reflect.Ptr {
+		t, nullable = t.Elem(), true
+	}
+	tok, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+	want := "string"
+	switch t.Kind() {
+	case reflect.Struct:
+		if tok == json.Delim('{') {
+			return d.object(t, pointer)
+		}
+		want = "object"
+	case reflect.Bool:
+		if _, ok := tok.(bool); ok {
+			return nil
+		}
+		want = "boolean"
+	case reflect.String:
+		if _, ok := tok.(string); ok {
+			return nil
+		}
+	}
+	if tok == nil && nullable {
+		return nil
+	}
+	d.errors.Add(&JSONFieldError{
+		Pointer: pointer,
+		Rule:    RuleType,
+		Msg:     fmt.Sprintf("expected %s but found %s", want, jsonType(tok)),
+	})
+	return d.skip(tok)
+}
+
+// object checks the fields of an object, whose opening brace has been read, are fields of t
+func (d *strictDecoder) object(t reflect.Type, pointer string) error {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.IsExported() && sf.Tag.Get("json") != "-" {
+			fields[jsonName(sf)] = sf.Type
+		}
+	}
+	for d.dec.More() {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+		child := pointer + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+		if ft, ok := fields[key]; ok {
+			if err := d.value(ft, child, false); err != nil {
+				return err
+			}
+			continue
+		}
+		msg := fmt.Sprintf("unknown field %q of %s", key, t.Name())
+		for name := range fields {
+			if strings.EqualFold(name, key) {
+				msg += fmt.Sprintf(", did you mean %q?", name)
+			}
+		}
+		d.errors.Add(&JSONFieldError{Pointer: child, Rule: RuleUnknownField, Msg: msg})
+		tok, err = d.dec.Token()
+		if err != nil {
+			return err
+		}
+		if err := d.skip(tok); err != nil {
+			return err
+		}
+	}
+	_, err := d.dec.Token() // closing brace
+	return err
+}
+
+// skip reads the rest of the value starting with tok
+func (d *strictDecoder) skip(tok json.Token) error {
+	if tok != json.Delim('{') && tok != json.Delim('[') {
+		return nil
+	}
+	for depth := 1; depth > 0; {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+	return nil
+}
+
+// jsonType names the type of the JSON value starting with tok
+func jsonType(tok json.Token) string {
+	switch tok.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	}
+	if tok == json.Delim('[') {
+		return "array"
+	}
+	return "object"
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire/wirepb"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func compileJSONSchema(t *testing.T) *jsonschema.Schema {
+	t.Helper()
+
+	compiler := jsonschema.NewCompiler()
+	require.NoError(t, compiler.AddResource("wire.schema.json", strings.NewReader(wirepb.JSONSchema)))
+	schema, err := compiler.Compile("wire.schema.json")
+	require.NoError(t, err)
+	return schema
+}
+
+func TestFile__FileFromJSONStrict(t *testing.T) {
+	schema := compileJSONSchema(t)
+
+	paths, err := filepath.Glob(filepath.Join("test", "testdata", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			bs, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			file, err := FileFromJSON(bs)
+			require.NoError(t, err)
+			strict, err := FileFromJSONStrict(bs)
+			require.NoError(t, err)
+			require.Equal(t, file, strict)
+
+			var doc interface{}
+			require.NoError(t, json.Unmarshal(bs, &doc))
+			require.NoError(t, schema.Validate(doc))
+		})
+	}
+}
+
+func TestFile__FileFromJSONStrictErrors(t *testing.T) {
+	schema := compileJSONSchema(t)
+
+	bs := []byte(`{
+  "id": "12345",
+  "fedWireMessage": {
+    "previousMessageIdentifier": {"previousMessageIdentifier": "PMI"},
+    "localInstrument": {"LocalInstrumentCode": "ANSI", "proprietaryCode": null},
+    "amount": {"amount": 100},
+    "typeSubType": null
+  },
+  "extra": [1, {"a": 2}]
+}`)
+
+	// FileFromJSON drops unknown keys
+	file, err := FileFromJSON([]byte(`{"fedWireMessage": {"localInstrument": {"LocalInstrumentCode": "ANSI"}}}`))
+	require.NoError(t, err)
+	require.Empty(t, file.FEDWireMessage.LocalInstrument.LocalInstrumentCode)
+
+	_, err = FileFromJSONStrict(bs)
+	require.Error(t, err)
+	list, ok := err.(base.ErrorList)
+	require.True(t, ok)
+
+	var pointers, rules []string
+	for _, err := range list {
+		je, ok := err.(*JSONFieldError)
+		require.True(t, ok)
+		pointers = append(pointers, je.Pointer)
+		rules = append(rules, je.Rule)
+	}
+	require.Equal(t, []string{
+		"/fedWireMessage/previousMessageIdentifier/previousMessageIdentifier",
+		"/fedWireMessage/localInstrument/LocalInstrumentCode",
+		"/fedWireMessage/localInstrument/proprietaryCode",
+		"/fedWireMessage/amount/amount",
+		"/extra",
+	}, pointers)
+	require.Equal(t, []string{RuleUnknownField, RuleUnknownField, RuleType, RuleType, RuleUnknownField}, rules)
+	require.Contains(t, list[0].Error(), `did you mean "PreviousMessageIdentifier"?`)
+	require.Equal(t, `/fedWireMessage/amount/amount: expected string but found number`, list[3].Error())
+
+	issues := file.FEDWireMessage.IssuesFor(err)
+	require.Len(t, issues, 5)
+	require.Equal(t, "{3500}", issues[0].Tag)
+	require.Equal(t, "previousMessageIdentifier.previousMessageIdentifier", issues[0].Field)
+	require.Equal(t, "W-3500-UNKNOWN-FIELD", issues[0].Code)
+	require.Equal(t, "W-UNKNOWN-FIELD", issues[4].Code)
+	require.Equal(t, "/fedWireMessage/amount/amount", issues[3].Pointer)
+
+	// the JSON Schema rejects the same file
+	var doc interface{}
+	require.NoError(t, json.Unmarshal(bs, &doc))
+	require.Error(t, schema.Validate(doc))
+}
+
+func TestFile__FileFromJSONStrictUnknownKeys(t *testing.T) {
+	for _, key := range []string{"", "aQ", "bWzR", "ünïcode"} {
+		bs, err := json.Marshal(map[string]interface{}{
+			"fedWireMessage": map[string]interface{}{
+				"amount": map[string]string{key: "1"},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = FileFromJSONStrict(bs)
+		require.Error(t, err, key)
+
+		fwm := FEDWireMessage{}
+		issues := fwm.IssuesFor(err)
+		require.Len(t, issues, 1, key)
+		require.Equal(t, "W-2000-UNKNOWN-FIELD", issues[0].Code, key)
+		require.Equal(t, "amount."+key, issues[0].Field)
+	}
+}
+
+func TestFile__FileFromJSONStrictInvalid(t *testing.T) {
+	for _, bs := range []string{`{"id": `, `[]`, `null`, `{} {}`} {
+		_, err := FileFromJSONStrict([]byte(bs))
+		require.Error(t, err, bs)
+	}
+}
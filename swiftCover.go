@@ -0,0 +1,447 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// MT103 and MT202COV are the SWIFT message types translated to and from COVS messages
+const (
+	MT103    = "MT103"
+	MT202COV = "MT202COV"
+)
+
+var (
+	swiftMessageTypeRegex = regexp.MustCompile(`\{2:[IO](\d{3})`)
+	swiftFieldRegex       = regexp.MustCompile(`^:(\d{2}[A-Z]?):(.*)$`)
+	swiftAmountRegex      = regexp.MustCompile(`^(\d{6})([A-Z]{3})(\d[\d,]*)$`)
+	swiftCurrencyRegex    = regexp.MustCompile(`^([A-Z]{3})(\d[\d,]*)$`)
+)
+
+// swiftCoverField maps a SWIFT field onto the Fedwire cover payment tag that carries it
+type swiftCoverField struct {
+	// tag is the Fedwire tag
+	tag string
+	// field is the SWIFT field number
+	field string
+	// options are the permitted SWIFT field options, "" being a field without one
+	options []string
+	// lines is the number of SWIFT lines the tag holds
+	lines int
+	// get returns the CoverPayment of the tag in fwm, or nil
+	get func(fwm *FEDWireMessage) *CoverPayment
+	// set adds the tag to fwm with cp
+	set func(fwm *FEDWireMessage, cp CoverPayment)
+}
+
+// swiftCoverFields are the SWIFT fields of an MT103, or sequence B of an MT202COV, in message order
+var swiftCoverFields = []swiftCoverField{
+	{
+		tag: TagOrderingCustomer, field: "50", options: []string{"A", "F", "K"}, lines: 5,
+		get: func(fwm *FEDWireMessage) *CoverPayment {
+			if fwm.OrderingCustomer == nil {
+				return nil
+			}
+			return &fwm.OrderingCustomer.CoverPayment
+		},
+		set: func(fwm *FEDWireMessage, cp CoverPayment) {
+			fwm.OrderingCustomer = NewOrderingCustomer()
+			fwm.OrderingCustomer.CoverPayment = cp
+		},
+	},
+	{
+		tag: TagOrderingInstitution, field: "52", options: []string{"A", "D"}, lines: 5,
+		get: func(fwm *FEDWireMessage) *CoverPayment {
+			if fwm.OrderingInstitution == nil {
+				return nil
+			}
+			return &fwm.OrderingInstitution.CoverPayment
+		},
+		set: func(fwm *FEDWireMessage, cp CoverPayment) {
+			fwm.OrderingInstitution = NewOrderingInstitution()
+			fwm.OrderingInstitution.CoverPayment = cp
+		},
+	},
+	{
+		tag: TagIntermediaryInstitution, field: "56", options: []string{"A", "C", "D"}, lines: 5,
+		get: func(fwm *FEDWireMessage) *CoverPayment {
+			if fwm.IntermediaryInstitution == nil {
+				return nil
+			}
+			return &fwm.IntermediaryInstitution.CoverPayment
+		},
+		set: func(fwm *FEDWireMessage, cp CoverPayment) {
+			fwm.IntermediaryInstitution = NewIntermediaryInstitution()
+			fwm.IntermediaryInstitution.CoverPayment = cp
+		},
+	},
+	{
+		tag: TagInstitutionAccount, field: "57", options: []string{"A", "B", "C", "D"}, lines: 5,
+		get: func(fwm *FEDWireMessage) *CoverPayment {
+			if fwm.InstitutionAccount == nil {
+				return nil
+			}
+			return &fwm.InstitutionAccount.CoverPayment
+		},
+		set: func(fwm *FEDWireMessage, cp CoverPayment) {
+			fwm.InstitutionAccount = NewInstitutionAccount()
+			fwm.InstitutionAccount.CoverPayment = cp
+		},
+	},
+	{
+		tag: TagBeneficiaryCustomer, field: "59", options: []string{"", "A", "F"}, lines: 5,
+		get: func(fwm *FEDWireMessage) *CoverPayment {
+			if fwm.BeneficiaryCustomer == nil {
+				return nil
+			}
+			return &fwm.BeneficiaryCustomer.CoverPayment
+		},
+		set: func(fwm *FEDWireMessage, cp CoverPayment) {
+			fwm.BeneficiaryCustomer = NewBeneficiaryCustomer()
+			fwm.BeneficiaryCustomer.CoverPayment = cp
+		},
+	},
+	{
+		tag: TagRemittance, field: "70", options: []string{""}, lines: 4,
+		get: func(fwm *FEDWireMessage) *CoverPayment {
+			if fwm.Remittance == nil {
+				return nil
+			}
+			return &fwm.Remittance.CoverPayment
+		},
+		set: func(fwm *FEDWireMessage, cp CoverPayment) {
+			fwm.Remittance = NewRemittance()
+			fwm.Remittance.CoverPayment = cp
+		},
+	},
+	{
+		tag: TagSenderToReceiver, field: "72", options: []string{""}, lines: 6,
+		get: func(fwm *FEDWireMessage) *CoverPayment {
+			if fwm.SenderToReceiver == nil {
+				return nil
+			}
+			return &fwm.SenderToReceiver.CoverPayment
+		},
+		set: func(fwm *FEDWireMessage, cp CoverPayment) {
+			fwm.SenderToReceiver = NewSenderToReceiver()
+			fwm.SenderToReceiver.CoverPayment = cp
+		},
+	},
+}
+
+// swiftField is a field of a SWIFT block 4
+type swiftField struct {
+	tag   string
+	lines []string
+}
+
+// ParseSwiftCoverPayment builds a COVS FEDWireMessage from the block 4 text of an MT103, or the
+// underlying customer credit transfer (sequence B) of an MT202COV. Complete messages are accepted and
+// the message type is taken from block 2 when present.
+//
+// The message carries BusinessFunctionCode, LocalInstrument, Amount (field 32A, which must be in USD),
+// SenderReference (field 20), BeneficiaryReference (field 20 of an MT103 or 21 of an MT202COV) and the
+// {7033} through {7072} cover payment tags. An MT202COV field 58A becomes BeneficiaryFI and a field 58D of
+// //FW and an ABA number the ReceiverDepositoryInstitution. Callers complete the remaining mandatory tags.
+func ParseSwiftCoverPayment(text string) (*FEDWireMessage, error) {
+	messageType, fields := parseSwiftBlock4(text)
+	if messageType == "" {
+		messageType = MT103
+		for _, f := range fields {
+			if f.tag == "21" || strings.HasPrefix(f.tag, "58") {
+				messageType = MT202COV
+			}
+		}
+	}
+
+	fwm := &FEDWireMessage{}
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.BusinessFunctionCode.TransactionTypeCode = "   "
+	fwm.LocalInstrument = NewLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = SequenceBCoverPaymentStructured
+
+	sequenceB := messageType == MT103
+	for _, f := range fields {
+		value := strings.Join(f.lines, "\n")
+		switch {
+		case f.tag == "20":
+			fwm.SenderReference = NewSenderReference()
+			fwm.SenderReference.SenderReference = value
+			if messageType == MT103 {
+				fwm.BeneficiaryReference = NewBeneficiaryReference()
+				fwm.BeneficiaryReference.BeneficiaryReference = value
+			}
+		case f.tag == "21":
+			fwm.BeneficiaryReference = NewBeneficiaryReference()
+			fwm.BeneficiaryReference.BeneficiaryReference = value
+		case f.tag == "32A":
+			amount, err := swiftSettlementAmount(value)
+			if err != nil {
+				return nil, err
+			}
+			fwm.Amount = amount
+		case f.tag == "33B":
+			if !swiftCurrencyRegex.MatchString(value) {
+				return nil, fieldError("33B", ErrSwiftField, value)
+			}
+			fwm.CurrencyInstructedAmount = NewCurrencyInstructedAmount()
+			fwm.CurrencyInstructedAmount.SwiftFieldTag = f.tag
+			fwm.CurrencyInstructedAmount.Amount = value
+		case f.tag == "58A" && !sequenceB:
+			fwm.BeneficiaryFI = NewBeneficiaryFI()
+			fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode = SWIFTBankIdentifierCode
+			fwm.BeneficiaryFI.FinancialInstitution.Identifier = f.lines[len(f.lines)-1]
+		case f.tag == "58D" && !sequenceB && strings.HasPrefix(f.lines[0], "//FW"):
+			fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+			fwm.ReceiverDepositoryInstitution.ReceiverABANumber = strings.TrimPrefix(f.lines[0], "//FW")
+			if len(f.lines) > 1 {
+				fwm.ReceiverDepositoryInstitution.ReceiverShortName = f.lines[1]
+			}
+		default:
+			if strings.HasPrefix(f.tag, "50") {
+				sequenceB = true
+			}
+			field := findSwiftCoverField(f.tag)
+			if field == nil || !sequenceB {
+				continue
+			}
+			if !field.permits(f.tag) {
+				return nil, fieldError(field.tag, ErrSwiftFieldTag, f.tag)
+			}
+			if len(f.lines) > field.lines {
+				return nil, fieldError(f.tag, ErrSwiftFieldLines, len(f.lines))
+			}
+			cp := CoverPayment{SwiftFieldTag: f.tag}
+			for i, line := range []*string{
+				&cp.SwiftLineOne, &cp.SwiftLineTwo, &cp.SwiftLineThree,
+				&cp.SwiftLineFour, &cp.SwiftLineFive, &cp.SwiftLineSix,
+			} {
+				if i < len(f.lines) {
+					*line = f.lines[i]
+				}
+			}
+			field.set(fwm, cp)
+		}
+	}
+	if !sequenceB {
+		return nil, fieldError("MessageType", ErrSwiftMessageType, messageType)
+	}
+	return fwm, nil
+}
+
+// parseSwiftBlock4 returns the message type in block 2 of text, if any, and the fields of block 4
+func parseSwiftBlock4(text string) (string, []swiftField) {
+	var messageType string
+	if m := swiftMessageTypeRegex.FindStringSubmatch(text); m != nil {
+		switch m[1] {
+		case "103":
+			messageType = MT103
+		case "202":
+			messageType = MT202COV
+		default:
+			messageType = "MT" + m[1]
+		}
+	}
+	if i := strings.Index(text, "{4:"); i >= 0 {
+		text = text[i+3:]
+	}
+	if i := strings.Index(text, "-}"); i >= 0 {
+		text = text[:i]
+	}
+
+	var fields []swiftField
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \r")
+		if m := swiftFieldRegex.FindStringSubmatch(line); m != nil {
+			fields = append(fields, swiftField{tag: m[1], lines: []string{m[2]}})
+		} else if len(fields) > 0 && line != "" {
+			f := &fields[len(fields)-1]
+			f.lines = append(f.lines, line)
+		}
+	}
+	return messageType, fields
+}
+
+// swiftSettlementAmount converts field 32A into a {2000} Amount
+func swiftSettlementAmount(value string) (*Amount, error) {
+	m := swiftAmountRegex.FindStringSubmatch(value)
+	if m == nil {
+		return nil, fieldError("32A", ErrSwiftField, value)
+	}
+	if m[2] != "USD" {
+		return nil, fieldError("32A", ErrSwiftCurrency, m[2])
+	}
+	r, _, err := parseDecimalAmount(m[3], ',')
+	if err != nil {
+		return nil, fieldError("32A", err, value)
+	}
+	cents := new(big.Rat).Mul(r, big.NewRat(100, 1))
+	if !cents.IsInt() || len(cents.Num().String()) > 12 {
+		return nil, fieldError("32A", ErrNonAmount, value)
+	}
+	amount := NewAmount()
+	amount.Amount = fmt.Sprintf("%012s", cents.Num().String())
+	return amount, nil
+}
+
+// findSwiftCoverField returns the cover field for a SWIFT field tag such as 50K
+func findSwiftCoverField(swiftFieldTag string) *swiftCoverField {
+	tag := normalizeSwiftFieldTag(swiftFieldTag)
+	for i := range swiftCoverFields {
+		if len(tag) >= 2 && swiftCoverFields[i].field == tag[:2] {
+			return &swiftCoverFields[i]
+		}
+	}
+	return nil
+}
+
+// permits reports if swiftFieldTag is one of the options of the field
+func (f *swiftCoverField) permits(swiftFieldTag string) bool {
+	tag := normalizeSwiftFieldTag(swiftFieldTag)
+	if !strings.HasPrefix(tag, f.field) {
+		return false
+	}
+	for _, option := range f.options {
+		if tag == f.field+option {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeSwiftFieldTag strips the colons and spaces a SwiftFieldTag may be written with (e.g. ":50K:")
+func normalizeSwiftFieldTag(swiftFieldTag string) string {
+	return strings.Trim(swiftFieldTag, ": ")
+}
+
+// ValidateSwiftFieldTags checks that the SwiftFieldTag of each cover payment tag in fwm names a SWIFT
+// field option the tag carries, e.g. 50A, 50F or 50K for OrderingCustomer and 33B for
+// CurrencyInstructedAmount.
+func (fwm *FEDWireMessage) ValidateSwiftFieldTags() error {
+	if cia := fwm.CurrencyInstructedAmount; cia != nil && normalizeSwiftFieldTag(cia.SwiftFieldTag) != "33B" {
+		return fieldError("CurrencyInstructedAmount", ErrSwiftFieldTag, cia.SwiftFieldTag)
+	}
+	for i := range swiftCoverFields {
+		field := &swiftCoverFields[i]
+		if cp := field.get(fwm); cp != nil && !field.permits(cp.SwiftFieldTag) {
+			return fieldError(field.tag, ErrSwiftFieldTag, cp.SwiftFieldTag)
+		}
+	}
+	return nil
+}
+
+// SwiftMT103 renders the block 4 text of an MT103 from a COVS message. Field 32A is dated with the
+// InputCycleDate and field 71A, which Fedwire doesn't carry, is SHA.
+func (fwm *FEDWireMessage) SwiftMT103() (string, error) {
+	return fwm.swiftMessage(MT103)
+}
+
+// SwiftMT202COV renders the block 4 text of an MT202COV from a COVS message. Field 58a is the
+// BeneficiaryFI when it's identified by BIC, otherwise the ReceiverDepositoryInstitution.
+func (fwm *FEDWireMessage) SwiftMT202COV() (string, error) {
+	return fwm.swiftMessage(MT202COV)
+}
+
+// swiftMessage renders the block 4 text of messageType
+func (fwm *FEDWireMessage) swiftMessage(messageType string) (string, error) {
+	if fwm.LocalInstrument == nil || fwm.LocalInstrument.LocalInstrumentCode != SequenceBCoverPaymentStructured {
+		return "", fieldError("LocalInstrument", ErrLocalInstrumentCode, fwm.LocalInstrument)
+	}
+	if err := fwm.ValidateSwiftFieldTags(); err != nil {
+		return "", err
+	}
+	if fwm.SenderReference == nil {
+		return "", fieldError("SenderReference", ErrFieldRequired)
+	}
+	if fwm.BeneficiaryReference == nil {
+		return "", fieldError("BeneficiaryReference", ErrFieldRequired)
+	}
+	if fwm.Amount == nil {
+		return "", fieldError("Amount", ErrFieldRequired)
+	}
+	if fwm.InputMessageAccountabilityData == nil || len(fwm.InputMessageAccountabilityData.InputCycleDate) != 8 {
+		return "", fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	cents, ok := new(big.Int).SetString(fwm.Amount.Amount, 10)
+	if !ok {
+		return "", fieldError("Amount", ErrNonAmount, fwm.Amount.Amount)
+	}
+
+	var buf strings.Builder
+	add := func(tag string, lines ...string) {
+		var nonEmpty []string
+		for _, line := range lines {
+			if line != "" {
+				nonEmpty = append(nonEmpty, line)
+			}
+		}
+		if len(nonEmpty) > 0 {
+			buf.WriteString(":" + tag + ":" + strings.Join(nonEmpty, "\r\n") + "\r\n")
+		}
+	}
+	amount := strings.Replace(new(big.Rat).SetFrac(cents, big.NewInt(100)).FloatString(2), ".", ",", 1)
+	settlement := fwm.InputMessageAccountabilityData.InputCycleDate[2:] + "USD" + amount
+
+	buf.WriteString("{4:\r\n")
+	add("20", fwm.SenderReference.SenderReference)
+	if messageType == MT103 {
+		add("23B", "CRED")
+		add("32A", settlement)
+		fwm.addSwiftInstructedAmount(add)
+	} else {
+		add("21", fwm.BeneficiaryReference.BeneficiaryReference)
+		add("32A", settlement)
+		switch {
+		case fwm.BeneficiaryFI != nil && fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode == SWIFTBankIdentifierCode:
+			add("58A", fwm.BeneficiaryFI.FinancialInstitution.Identifier)
+		case fwm.ReceiverDepositoryInstitution != nil:
+			add("58D", "//FW"+fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+		default:
+			return "", fieldError("BeneficiaryFI", ErrFieldRequired)
+		}
+	}
+	for i := range swiftCoverFields {
+		field := &swiftCoverFields[i]
+		cp := field.get(fwm)
+		if cp == nil {
+			continue
+		}
+		if messageType == MT103 && field.tag == TagSenderToReceiver {
+			add("71A", "SHA")
+		}
+		add(normalizeSwiftFieldTag(cp.SwiftFieldTag), cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree,
+			cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix)
+	}
+	if messageType == MT103 && fwm.SenderToReceiver == nil {
+		add("71A", "SHA")
+	}
+	if messageType == MT202COV {
+		fwm.addSwiftInstructedAmount(add)
+	}
+	buf.WriteString("-}")
+	return buf.String(), nil
+}
+
+// addSwiftInstructedAmount adds field 33B from CurrencyInstructedAmount. An amount without a currency
+// code is in USD.
+func (fwm *FEDWireMessage) addSwiftInstructedAmount(add func(tag string, lines ...string)) {
+	if cia := fwm.CurrencyInstructedAmount; cia != nil {
+		amount := cia.Amount
+		if !swiftCurrencyRegex.MatchString(amount) {
+			// {7033} amounts are zero filled to 18 characters
+			amount = strings.TrimLeft(amount, "0")
+			if amount == "" || amount[0] == ',' {
+				amount = "0" + amount
+			}
+			amount = "USD" + amount
+		}
+		add("33B", amount)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// swiftText joins lines with the SWIFT line separator
+func swiftText(lines ...string) string {
+	return strings.Join(lines, "\r\n")
+}
+
+var mockMT103 = swiftText(
+	"{4:",
+	":20:REF-103-001",
+	":23B:CRED",
+	":32A:240212USD1234,56",
+	":33B:EUR1130,00",
+	":50K:/12345678",
+	"JOHN DOE",
+	"1 MAIN STREET",
+	"NEW YORK NY",
+	":52A:BANKDEFFXXX",
+	":57A:BANKUS33XXX",
+	":59:/98765432",
+	"JANE ROE",
+	"2 HIGH STREET",
+	":70:INVOICE 2024-001",
+	":71A:SHA",
+	":72:/ACC/INSTRUCTIONS",
+	"-}",
+)
+
+func TestParseSwiftCoverPayment_MT103(t *testing.T) {
+	fwm, err := ParseSwiftCoverPayment(mockMT103)
+	require.NoError(t, err)
+
+	require.Equal(t, CustomerTransferPlus, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, SequenceBCoverPaymentStructured, fwm.LocalInstrument.LocalInstrumentCode)
+	require.Equal(t, "000000123456", fwm.Amount.Amount)
+	require.Equal(t, "REF-103-001", fwm.SenderReference.SenderReference)
+	require.Equal(t, "REF-103-001", fwm.BeneficiaryReference.BeneficiaryReference)
+	require.Equal(t, "EUR1130,00", fwm.CurrencyInstructedAmount.Amount)
+	require.Equal(t, CoverPayment{
+		SwiftFieldTag:  "50K",
+		SwiftLineOne:   "/12345678",
+		SwiftLineTwo:   "JOHN DOE",
+		SwiftLineThree: "1 MAIN STREET",
+		SwiftLineFour:  "NEW YORK NY",
+	}, fwm.OrderingCustomer.CoverPayment)
+	require.Equal(t, "52A", fwm.OrderingInstitution.CoverPayment.SwiftFieldTag)
+	require.Equal(t, "BANKUS33XXX", fwm.InstitutionAccount.CoverPayment.SwiftLineOne)
+	require.Equal(t, "59", fwm.BeneficiaryCustomer.CoverPayment.SwiftFieldTag)
+	require.Equal(t, "INVOICE 2024-001", fwm.Remittance.CoverPayment.SwiftLineOne)
+	require.Equal(t, "/ACC/INSTRUCTIONS", fwm.SenderToReceiver.CoverPayment.SwiftLineOne)
+	require.Nil(t, fwm.IntermediaryInstitution)
+
+	// completed with the tags SWIFT doesn't carry the message is valid
+	fwm.SenderSupplied = mockSenderSupplied()
+	fwm.TypeSubType = mockTypeSubType()
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20240212"
+	fwm.SenderDepositoryInstitution = mockSenderDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution = mockReceiverDepositoryInstitution()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.ValidateOptions = &ValidateOpts{SkipMandatoryIMAD: true}
+	require.NoError(t, fwm.verify())
+
+	mt, err := fwm.SwiftMT103()
+	require.NoError(t, err)
+	require.Equal(t, mockMT103, mt)
+}
+
+func TestParseSwiftCoverPayment_MT202COV(t *testing.T) {
+	mt202 := swiftText(
+		"{1:F01BANKUS33AXXX0000000000}{2:I202BANKUS44XXXXN}{3:{119:COV}}{4:",
+		":20:REF-202-001",
+		":21:REF-103-001",
+		":32A:240212USD1234,56",
+		":52A:BANKSEQAXXX",
+		":58D://FW121042882",
+		"WELLS FARGO NA",
+		":50F:/12345678",
+		"1/JOHN DOE",
+		":59A:/98765432",
+		"BANKBENEXXX",
+		":72:/INS/BANKDEFF",
+		":33B:USD1234,56",
+		"-}",
+	)
+	fwm, err := ParseSwiftCoverPayment(mt202)
+	require.NoError(t, err)
+
+	require.Equal(t, "REF-202-001", fwm.SenderReference.SenderReference)
+	require.Equal(t, "REF-103-001", fwm.BeneficiaryReference.BeneficiaryReference)
+	require.Equal(t, "121042882", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, "WELLS FARGO NA", fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	// sequence A institutions aren't part of the customer transfer
+	require.Nil(t, fwm.OrderingInstitution)
+	require.Equal(t, "50F", fwm.OrderingCustomer.CoverPayment.SwiftFieldTag)
+	require.Equal(t, "BANKBENEXXX", fwm.BeneficiaryCustomer.CoverPayment.SwiftLineTwo)
+	require.Equal(t, "/INS/BANKDEFF", fwm.SenderToReceiver.CoverPayment.SwiftLineOne)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20240212"
+	mt, err := fwm.SwiftMT202COV()
+	require.NoError(t, err)
+	require.Equal(t, swiftText(
+		"{4:",
+		":20:REF-202-001",
+		":21:REF-103-001",
+		":32A:240212USD1234,56",
+		":58D://FW121042882",
+		"WELLS FARGO NA",
+		":50F:/12345678",
+		"1/JOHN DOE",
+		":59A:/98765432",
+		"BANKBENEXXX",
+		":72:/INS/BANKDEFF",
+		":33B:USD1234,56",
+		"-}",
+	), mt)
+
+	// a plain MT202 has no underlying customer transfer
+	_, err = ParseSwiftCoverPayment(swiftText("{2:I202BANKUS44XXXXN}{4:", ":20:REF", ":21:REL", ":32A:240212USD1,00", ":58A:BANKUS33", "-}"))
+	require.ErrorIs(t, err, ErrSwiftMessageType)
+}
+
+func TestParseSwiftCoverPayment_errors(t *testing.T) {
+	_, err := ParseSwiftCoverPayment(strings.Replace(mockMT103, ":52A:", ":52B:", 1))
+	require.EqualError(t, err, fieldError(TagOrderingInstitution, ErrSwiftFieldTag, "52B").Error())
+
+	_, err = ParseSwiftCoverPayment(strings.Replace(mockMT103, "USD1234,56", "EUR1234,56", 1))
+	require.ErrorIs(t, err, ErrSwiftCurrency)
+
+	_, err = ParseSwiftCoverPayment(strings.Replace(mockMT103, ":70:INVOICE 2024-001", ":70:1\r\n2\r\n3\r\n4\r\n5", 1))
+	require.ErrorIs(t, err, ErrSwiftFieldLines)
+
+	_, err = ParseSwiftCoverPayment("{2:O940BANKUS33XXXXN}{4:\r\n:20:STATEMENT\r\n-}")
+	require.ErrorIs(t, err, ErrSwiftMessageType)
+}
+
+func TestFEDWireMessage_ValidateSwiftFieldTags(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.OrderingCustomer = mockOrderingCustomer()
+	fwm.OrderingCustomer.CoverPayment.SwiftFieldTag = ":50K:"
+	fwm.CurrencyInstructedAmount = mockCurrencyInstructedAmount()
+	fwm.CurrencyInstructedAmount.SwiftFieldTag = "33B"
+	require.NoError(t, fwm.ValidateSwiftFieldTags())
+
+	fwm.OrderingCustomer.CoverPayment.SwiftFieldTag = "59"
+	require.EqualError(t, fwm.ValidateSwiftFieldTags(), fieldError(TagOrderingCustomer, ErrSwiftFieldTag, "59").Error())
+
+	// {7033} amounts without a currency are rendered in USD
+	fwm = mockCustomerTransferData()
+	fwm.CurrencyInstructedAmount = mockCurrencyInstructedAmount()
+	fwm.CurrencyInstructedAmount.SwiftFieldTag = "33B"
+	fwm.CurrencyInstructedAmount.Amount = "000000000000000,49"
+	var lines []string
+	fwm.addSwiftInstructedAmount(func(tag string, l ...string) { lines = append(lines, tag+":"+l[0]) })
+	require.Equal(t, []string{"33B:USD0,49"}, lines)
+}
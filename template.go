@@ -0,0 +1,132 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+var (
+	// placeholderRegex matches the placeholders of a Template, e.g. {{amount}}
+	placeholderRegex = regexp.MustCompile(`\{\{\s*([A-Za-z][A-Za-z0-9_]*)\s*\}\}`)
+
+	// ErrTemplateName is the error given when a Template has no name
+	ErrTemplateName = errors.New("template has no name")
+)
+
+// Template is a named, partial FEDWireMessage for wires which repeat the same beneficiary, financial
+// institutions and remittance layout. Its text fields may hold placeholders, such as {{amount}} or
+// {{senderReference}}, which are replaced with values when it's rendered.
+type Template struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// FEDWireMessage holds the fields of every message rendered, including ValidateOptions
+	FEDWireMessage FEDWireMessage `json:"fedWireMessage"`
+}
+
+// Validate checks the template has a name
+func (t *Template) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return ErrTemplateName
+	}
+	return nil
+}
+
+// Placeholders returns the names of the placeholders in the template, sorted
+func (t *Template) Placeholders() []string {
+	names := make(map[string]bool)
+	eachTemplateField(reflect.ValueOf(&t.FEDWireMessage).Elem(), "", func(_ string, v reflect.Value) {
+		for _, m := range placeholderRegex.FindAllStringSubmatch(v.String(), -1) {
+			names[m[1]] = true
+		}
+	})
+	out := make([]string, 0, len(names))
+	for name := range names {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Render returns a File holding the message of the template with each placeholder replaced by its
+// value, and validates it. An error is returned without a File when a placeholder has no value or a
+// value has no placeholder. Files which fail validation are returned along with the error.
+func (t *Template) Render(values map[string]string) (*File, error) {
+	// copy the message, which also sets the tag of each tag
+	bs, err := json.Marshal(t.FEDWireMessage)
+	if err != nil {
+		return nil, fmt.Errorf("problem rendering template: %v", err)
+	}
+	file := NewFile()
+	if err := json.Unmarshal(bs, &file.FEDWireMessage); err != nil {
+		return nil, fmt.Errorf("problem rendering template: %v", err)
+	}
+
+	var errs base.ErrorList
+	used := make(map[string]bool)
+	eachTemplateField(reflect.ValueOf(&file.FEDWireMessage).Elem(), "", func(path string, v reflect.Value) {
+		v.SetString(placeholderRegex.ReplaceAllStringFunc(v.String(), func(placeholder string) string {
+			name := placeholderRegex.FindStringSubmatch(placeholder)[1]
+			value, ok := values[name]
+			if !ok {
+				errs.Add(fmt.Errorf("%s: no value for placeholder %s", path, placeholder))
+				return placeholder
+			}
+			used[name] = true
+			return value
+		}))
+	})
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !used[name] {
+			errs.Add(fmt.Errorf("template has no placeholder for %q", name))
+		}
+	}
+	if !errs.Empty() {
+		return nil, errs
+	}
+	return file, file.Validate()
+}
+
+// eachTemplateField calls fn with the path, of JSON names, and value of each text field within v
+func eachTemplateField(v reflect.Value, path string, fn func(path string, v reflect.Value)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() || sf.Tag.Get("json") == "-" {
+			continue
+		}
+		field := v.Field(i)
+		fieldPath := jsonName(sf)
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+		switch field.Kind() {
+		case reflect.Struct:
+			eachTemplateField(field, fieldPath, fn)
+		case reflect.String:
+			fn(fieldPath, field)
+		}
+	}
+}
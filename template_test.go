@@ -0,0 +1,88 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/base"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mockTemplate(t *testing.T) *Template {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.json"))
+	require.NoError(t, err)
+	file, err := FileFromJSON(bs)
+	require.NoError(t, err)
+
+	fwm := file.FEDWireMessage
+	fwm.Amount.Amount = "{{amount}}"
+	fwm.SenderReference.SenderReference = "{{ senderReference }}"
+	fwm.BeneficiaryReference.BeneficiaryReference = "INV {{senderReference}}"
+	return &Template{
+		ID:             "monthly-rent",
+		Name:           "Monthly rent",
+		FEDWireMessage: fwm,
+	}
+}
+
+func TestTemplate__Render(t *testing.T) {
+	tmpl := mockTemplate(t)
+	require.NoError(t, tmpl.Validate())
+	require.Equal(t, []string{"amount", "senderReference"}, tmpl.Placeholders())
+
+	file, err := tmpl.Render(map[string]string{
+		"amount":          "000000250000",
+		"senderReference": "RENT-2024-05",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "000000250000", file.FEDWireMessage.Amount.Amount)
+	require.Equal(t, "RENT-2024-05", file.FEDWireMessage.SenderReference.SenderReference)
+	require.Equal(t, "INV RENT-2024-05", file.FEDWireMessage.BeneficiaryReference.BeneficiaryReference)
+	require.Contains(t, file.FEDWireMessage.Amount.String(), TagAmount)
+
+	// the template is unchanged
+	require.Equal(t, "{{amount}}", tmpl.FEDWireMessage.Amount.Amount)
+}
+
+func TestTemplate__RenderInvalid(t *testing.T) {
+	tmpl := mockTemplate(t)
+
+	file, err := tmpl.Render(map[string]string{
+		"amount":          "12.50",
+		"senderReference": "RENT-2024-05",
+	})
+	require.Error(t, err)
+	require.NotNil(t, file)
+	require.Equal(t, "/fedWireMessage/amount/amount", file.FEDWireMessage.IssueFor(err).Pointer)
+}
+
+func TestTemplate__RenderValues(t *testing.T) {
+	tmpl := mockTemplate(t)
+
+	file, err := tmpl.Render(map[string]string{
+		"amount":   "000000250000",
+		"ammount":  "000000250000",
+		"referenc": "RENT-2024-05",
+	})
+	require.Nil(t, file)
+	list, ok := err.(base.ErrorList)
+	require.True(t, ok)
+	require.Len(t, list, 4)
+	require.EqualError(t, list[0], "senderReference.senderReference: no value for placeholder {{ senderReference }}")
+	require.EqualError(t, list[1], "beneficiaryReference.beneficiaryReference: no value for placeholder {{senderReference}}")
+	require.EqualError(t, list[2], `template has no placeholder for "ammount"`)
+	require.EqualError(t, list[3], `template has no placeholder for "referenc"`)
+}
+
+func TestTemplate__Validate(t *testing.T) {
+	tmpl := &Template{Name: " "}
+	require.Equal(t, ErrTemplateName, tmpl.Validate())
+}
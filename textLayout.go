@@ -0,0 +1,59 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// WrapText word-wraps text across lines of the given widths, one width per line field of a tag. Runs of
+// whitespace (including newlines) become a single space and a word longer than its line is split.
+//
+// Text with characters outside the wire character set returns ErrNonAlphanumeric. When the text doesn't
+// fit, the lines that could be filled are returned along with ErrTextOverflow and the remaining text.
+func WrapText(text string, widths ...int) ([]string, error) {
+	words := strings.Fields(text)
+	v := &validator{}
+	if err := v.isAlphanumeric(strings.Join(words, " ")); err != nil {
+		return nil, fieldError("Text", err, text)
+	}
+
+	lines := make([]string, len(widths))
+	for i, width := range widths {
+		lines[i], words = fillLine(words, width)
+	}
+	if len(words) > 0 {
+		return lines, fieldError("Text", ErrTextOverflow, strings.Join(words, " "))
+	}
+	return lines, nil
+}
+
+// fillLine takes as many words as fit in width, splitting the first word when it's longer than width
+func fillLine(words []string, width int) (string, []string) {
+	if len(words) == 0 {
+		return "", words
+	}
+	if runes := []rune(words[0]); len(runes) > width {
+		return string(runes[:width]), append([]string{string(runes[width:])}, words[1:]...)
+	}
+	line, words := words[0], words[1:]
+	for len(words) > 0 && utf8.RuneCountInString(line)+1+utf8.RuneCountInString(words[0]) <= width {
+		line, words = line+" "+words[0], words[1:]
+	}
+	return line, words
+}
+
+// JoinText joins the lines of a tag back into text, separating lines with a single space and skipping
+// empty lines. It reverses WrapText except for words WrapText split, which come back with a space.
+func JoinText(lines ...string) string {
+	var parts []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			parts = append(parts, line)
+		}
+	}
+	return strings.Join(parts, " ")
+}
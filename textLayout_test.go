@@ -0,0 +1,39 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapText(t *testing.T) {
+	lines, err := WrapText("The quick brown fox\njumps over  the lazy dog", 10, 10, 10, 10, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"The quick", "brown fox", "jumps over", "the lazy", "dog"}, lines)
+	require.Equal(t, "The quick brown fox jumps over the lazy dog", JoinText(lines...))
+
+	// words longer than a line are split
+	lines, err = WrapText("ABCDEFGHIJKL MN", 5, 5, 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ABCDE", "FGHIJ", "KL MN", ""}, lines)
+
+	lines, err = WrapText("one two three four", 7, 7)
+	require.EqualError(t, err, fieldError("Text", ErrTextOverflow, "four").Error())
+	require.Equal(t, []string{"one two", "three"}, lines)
+
+	_, err = WrapText("caf®", 35)
+	require.ErrorIs(t, err, ErrNonAlphanumeric)
+
+	lines, err = WrapText("", 35, 35)
+	require.NoError(t, err)
+	require.Equal(t, []string{"", ""}, lines)
+}
+
+func TestJoinText(t *testing.T) {
+	require.Equal(t, "Line One Line Two", JoinText(" Line One ", "", "Line Two  "))
+	require.Empty(t, JoinText())
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"context"
+
+	"github.com/moov-io/base"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer returns the tracer which starts the OpenTelemetry spans of reading and validating files. Spans
+// are only recorded once an application installs a TracerProvider with otel.SetTracerProvider.
+func tracer() trace.Tracer {
+	return otel.Tracer("github.com/moov-io/wire")
+}
+
+// Span attributes set while reading and validating files
+const (
+	AttributeBusinessFunctionCode = attribute.Key("wire.business_function_code")
+	AttributeMessageCount         = attribute.Key("wire.message_count")
+	AttributeTagCount             = attribute.Key("wire.tag_count")
+	AttributeErrorCount           = attribute.Key("wire.error_count")
+	AttributeTag                  = attribute.Key("wire.tag")
+	AttributeLine                 = attribute.Key("wire.line")
+)
+
+// businessFunctionCodeAttribute returns the business function code of fwm, which is empty before {3600}
+// is read
+func (fwm *FEDWireMessage) businessFunctionCodeAttribute() attribute.KeyValue {
+	if fwm.BusinessFunctionCode == nil {
+		return AttributeBusinessFunctionCode.String("")
+	}
+	return AttributeBusinessFunctionCode.String(fwm.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+// endSpan records err, with party details redacted, and the number of errors it holds on span
+func endSpan(span trace.Span, err error) {
+	count := 0
+	if el, ok := err.(base.ErrorList); ok {
+		count = len(el)
+	} else if err != nil {
+		count = 1
+	}
+	span.SetAttributes(AttributeErrorCount.Int(count))
+	if err != nil {
+		err = RedactError(err, DefaultRedactionPolicy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// startVerifySpan starts the span of validating fwm as a child of any span in ctx
+func (fwm *FEDWireMessage) startVerifySpan(ctx context.Context) trace.Span {
+	_, span := tracer().Start(ctx, "wire.FEDWireMessage.verify", trace.WithAttributes(fwm.businessFunctionCodeAttribute()))
+	return span
+}
+
+// verifyWithContext runs verify in a span which is a child of any span in ctx
+func (fwm *FEDWireMessage) verifyWithContext(ctx context.Context) error {
+	span := fwm.startVerifySpan(ctx)
+	err := fwm.verify()
+	endSpan(span, err)
+	return err
+}
+
+// ValidateDetailedWithContext validates f like ValidateDetailed, recording the span of
+// ValidateWithContext with every error found as a child of any span in ctx.
+func (f *File) ValidateDetailedWithContext(ctx context.Context, sent ...*File) *ValidationResult {
+	span := f.FEDWireMessage.startVerifySpan(ctx)
+	result := f.validateDetailed(sent...)
+	if result.Valid() {
+		endSpan(span, nil)
+		return result
+	}
+	var errs base.ErrorList
+	for _, issue := range result.Errors {
+		errs.Add(issue.err)
+	}
+	endSpan(span, errs)
+	return result
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	out := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		out[kv.Key] = kv.Value
+	}
+	return out
+}
+
+func TestReader_ReadWithContext_spans(t *testing.T) {
+	recorder := recordSpans(t)
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	_, err = NewReader(bytes.NewReader(bs)).ReadWithContext(ctx, nil)
+	require.NoError(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	names := make(map[string]int)
+	for _, span := range spans {
+		names[span.Name()]++
+		if span.Name() != "parent" {
+			require.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID())
+		}
+	}
+	require.Equal(t, 1, names["wire.Reader.read"])
+	require.Equal(t, 1, names["wire.FEDWireMessage.verify"])
+	require.Greater(t, names["wire.Reader.parseTag"], 10)
+
+	for _, span := range spans {
+		if span.Name() == "wire.Reader.read" {
+			attrs := spanAttributes(span)
+			require.Equal(t, "CTR", attrs[AttributeBusinessFunctionCode].AsString())
+			require.Equal(t, int64(1), attrs[AttributeMessageCount].AsInt64())
+			require.Equal(t, int64(names["wire.Reader.parseTag"]), attrs[AttributeTagCount].AsInt64())
+			require.Equal(t, int64(0), attrs[AttributeErrorCount].AsInt64())
+		}
+	}
+}
+
+func TestReader_ReadWithContext_errorSpans(t *testing.T) {
+	recorder := recordSpans(t)
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	bs = bytes.Replace(bs, []byte("{2000}"), []byte("{2000}ABC"), 1)
+
+	_, err = NewReader(bytes.NewReader(bs)).ReadWithContext(context.Background(), nil)
+	require.Error(t, err)
+
+	var failed []string
+	for _, span := range recorder.Ended() {
+		if span.Status().Code != codes.Error {
+			continue
+		}
+		failed = append(failed, span.Name())
+		require.Equal(t, int64(1), spanAttributes(span)[AttributeErrorCount].AsInt64())
+		if span.Name() == "wire.Reader.parseTag" {
+			require.Equal(t, TagAmount, spanAttributes(span)[AttributeTag].AsString())
+		}
+	}
+	require.Equal(t, []string{"wire.Reader.parseTag", "wire.Reader.read"}, failed)
+}
+
+func TestFile_ValidateWithContext_spans(t *testing.T) {
+	recorder := recordSpans(t)
+
+	file := NewFile()
+	require.Error(t, file.ValidateWithContext(context.Background()))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "wire.FEDWireMessage.verify", spans[0].Name())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+}
+
+func TestFile_ValidateDetailedWithContext_spans(t *testing.T) {
+	recorder := recordSpans(t)
+
+	file := mockValidatedFile()
+	file.FEDWireMessage.LocalInstrument = mockLocalInstrument()
+	file.FEDWireMessage.BeneficiaryIntermediaryFI = mockBeneficiaryIntermediaryFI()
+	file.FEDWireMessage.BeneficiaryFI = nil
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	result := file.ValidateDetailedWithContext(ctx)
+	parent.End()
+	require.Len(t, result.Errors, 2)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "wire.FEDWireMessage.verify", spans[0].Name())
+	require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Equal(t, int64(2), spanAttributes(spans[0])[AttributeErrorCount].AsInt64())
+}
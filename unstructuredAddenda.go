@@ -6,6 +6,7 @@ package wire
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 	"unicode/utf8"
 )
@@ -38,18 +39,29 @@ func NewUnstructuredAddenda() *UnstructuredAddenda {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ua *UnstructuredAddenda) Parse(record string) error {
+	return ua.parse(record, false)
+}
+
+// parse reads record. When repair is set the addenda is the rest of the record and AddendaLength is
+// recomputed, rather than a length mismatch being an error.
+func (ua *UnstructuredAddenda) parse(record string, repair bool) error {
 	// First check ua.tag and ua.AddendaLength
 	if utf8.RuneCountInString(record) < 10 {
 		return NewTagWrongLengthErr(10, utf8.RuneCountInString(record))
 	}
 	ua.tag = record[:6]
+	if repair {
+		ua.Addenda = record[10:]
+		ua.AddendaLength = ua.addendaLength()
+		return nil
+	}
 	ua.AddendaLength = record[6:10]
 	al := ua.parseNumField(ua.AddendaLength)
 	// check RuneCount for entire record
 	if utf8.RuneCountInString(record) != 10+al {
 		return NewTagWrongLengthErr(10+al, utf8.RuneCountInString(record))
 	}
-	ua.Addenda = ua.parseStringField(record[10 : 10+al])
+	ua.Addenda = record[10:]
 	return nil
 }
 
@@ -73,7 +85,7 @@ func (ua *UnstructuredAddenda) String() string {
 	buf.Grow(10)
 	buf.WriteString(ua.tag)
 	buf.WriteString(ua.AddendaLengthField())
-	buf.Grow(ua.parseNumField(ua.AddendaLength))
+	buf.Grow(ua.parseNumField(ua.AddendaLengthField()))
 	buf.WriteString(ua.AddendaField())
 	return buf.String()
 }
@@ -83,7 +95,10 @@ func (ua *UnstructuredAddenda) String() string {
 // AddendaLength must be numeric, padded with leading zeros if less than four characters and must equal
 //
 //	length of content in Addenda Information (e.g., if content of Addenda Information is 987 characters,
-//	Addenda Length must be 0987).
+//	Addenda Length must be 0987). An empty AddendaLength is computed from Addenda when written.
+//
+// Addenda must be within the X12 or SWIFT MX ISO 20022 character set. The set permitted by the message's
+// LocalInstrument is checked by FEDWireMessage.
 func (ua *UnstructuredAddenda) Validate() error {
 	if err := ua.fieldInclusion(); err != nil {
 		return err
@@ -91,33 +106,123 @@ func (ua *UnstructuredAddenda) Validate() error {
 	if ua.tag != TagUnstructuredAddenda {
 		return fieldError("tag", ErrValidTagForType, ua.tag)
 	}
-	if err := ua.isNumeric(ua.AddendaLength); err != nil {
-		return fieldError("AddendaLength", err, ua.AddendaLength)
+	if ua.isAddendaCharset(ua.Addenda, ANSIX12format) != nil && ua.isAddendaCharset(ua.Addenda, ISO20022XMLformat) != nil {
+		return fieldError("Addenda", ErrNonAlphanumeric, ua.Addenda)
 	}
-	if err := ua.isAlphanumeric(ua.Addenda); err != nil {
-		return fieldError("Addenda", err, ua.Addenda)
+	if n := utf8.RuneCountInString(ua.Addenda); n > maxAddendaLength {
+		return fieldError("Addenda", ErrAddendaLength, n)
+	}
+	if ua.AddendaLength != "" {
+		if err := ua.isNumeric(ua.AddendaLength); err != nil {
+			return fieldError("AddendaLength", err, ua.AddendaLength)
+		}
+		if ua.AddendaLength != ua.addendaLength() {
+			return fieldError("AddendaLength", ErrAddendaLengthMismatch, ua.AddendaLength)
+		}
 	}
 
 	return nil
 }
 
+// validateCharset checks that Addenda only contains characters permitted for localInstrumentCode
+func (ua *UnstructuredAddenda) validateCharset(localInstrumentCode string) error {
+	if err := ua.isAddendaCharset(ua.Addenda, localInstrumentCode); err != nil {
+		return fieldError("Addenda", err, ua.Addenda)
+	}
+	return nil
+}
+
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
 func (ua *UnstructuredAddenda) fieldInclusion() error {
-	// If UnstructuredAddenda is defined, AddendaLength is required, however it could be "0000"), but
-	// I'm not sure of the point
-	if ua.AddendaLength == "" {
-		return fieldError("AddendaLength", ErrFieldRequired)
-	}
+	// AddendaLength is computed from Addenda when it's left empty and Addenda may be empty
 	return nil
 }
 
-// AddendaLengthField gets a string of the AddendaLength field
+// addendaLength returns the four digit length of Addenda
+func (ua *UnstructuredAddenda) addendaLength() string {
+	return fmt.Sprintf("%04d", utf8.RuneCountInString(ua.Addenda))
+}
+
+// AddendaLengthField gets a string of the AddendaLength field, computed from Addenda when empty
 func (ua *UnstructuredAddenda) AddendaLengthField() string {
+	if ua.AddendaLength == "" {
+		return ua.addendaLength()
+	}
 	return ua.alphaField(ua.AddendaLength, 4)
 }
 
 // AddendaField gets a string of the Addenda field
 func (ua *UnstructuredAddenda) AddendaField() string {
-	return ua.alphaField(ua.Addenda, uint(ua.parseNumField(ua.AddendaLength)))
+	return ua.alphaField(ua.Addenda, uint(ua.parseNumField(ua.AddendaLengthField())))
+}
+
+// SplitUnstructuredAddenda breaks addenda into UnstructuredAddenda of at most 8994 characters, one for
+// each FEDWireMessage needed to carry it. Parts end on a segment boundary of the localInstrumentCode
+// format where possible: the segment terminator for X12 and EDIFACT, the end of an element for XML and
+// a space otherwise.
+func SplitUnstructuredAddenda(localInstrumentCode, addenda string) []*UnstructuredAddenda {
+	boundary := addendaBoundary(localInstrumentCode, addenda)
+	runes := []rune(addenda)
+
+	var parts []*UnstructuredAddenda
+	for len(runes) > 0 {
+		n := len(runes)
+		if n > maxAddendaLength {
+			n = maxAddendaLength
+			for i := n - 1; i > 0; i-- {
+				if boundary(runes, i) {
+					n = i + 1
+					break
+				}
+			}
+		}
+		ua := NewUnstructuredAddenda()
+		ua.Addenda = string(runes[:n])
+		ua.AddendaLength = ua.addendaLength()
+		parts = append(parts, ua)
+		runes = runes[n:]
+	}
+	return parts
+}
+
+// JoinUnstructuredAddenda returns the addenda split across parts by SplitUnstructuredAddenda
+func JoinUnstructuredAddenda(parts ...*UnstructuredAddenda) string {
+	var buf strings.Builder
+	for _, ua := range parts {
+		if ua != nil {
+			buf.WriteString(ua.Addenda)
+		}
+	}
+	return buf.String()
+}
+
+// addendaBoundary returns a func reporting if addenda can be split after runes[i]
+func addendaBoundary(localInstrumentCode, addenda string) func(runes []rune, i int) bool {
+	switch localInstrumentCode {
+	case ANSIX12format, STP820format:
+		terminator := []rune(x12SegmentTerminator)[0]
+		if s := strings.TrimSpace(addenda); strings.HasPrefix(s, "ISA") && len(s) >= x12ISALength {
+			terminator = rune(s[x12ISALength-1])
+		}
+		return func(runes []rune, i int) bool {
+			return runes[i] == terminator
+		}
+	case UNEDIFACTformat:
+		d := defaultEDIFACTDelimiters
+		if s := strings.TrimSpace(addenda); strings.HasPrefix(s, "UNA") && len(s) >= 9 {
+			d = edifactDelimiters{s[3], s[4], s[5], s[6], s[8]}
+		}
+		return func(runes []rune, i int) bool {
+			return runes[i] == rune(d.segment) && runes[i-1] != rune(d.release)
+		}
+	case GeneralXMLformat, ISO20022XMLformat:
+		return func(runes []rune, i int) bool {
+			return runes[i] == '>'
+		}
+	default:
+		return func(runes []rune, i int) bool {
+			return runes[i] == ' '
+		}
+	}
 }
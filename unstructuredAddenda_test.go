@@ -42,14 +42,23 @@ func TestAddendaAlphaNumeric(t *testing.T) {
 	require.EqualError(t, err, fieldError("Addenda", ErrNonAlphanumeric, ua.Addenda).Error())
 }
 
-// TestUnstructuredAddendaLengthRequired validates UnstructuredAddenda Length is required
-func TestAddendaLengthRequired(t *testing.T) {
+// TestAddendaLengthComputed validates an empty UnstructuredAddenda Length is computed when written
+func TestAddendaLengthComputed(t *testing.T) {
 	ua := mockUnstructuredAddenda()
 	ua.AddendaLength = ""
 
+	require.NoError(t, ua.Validate())
+	require.Equal(t, "{8200}0020Unstructured Addenda", ua.String())
+}
+
+// TestAddendaLengthMismatch validates UnstructuredAddenda Length must equal the length of Addenda
+func TestAddendaLengthMismatch(t *testing.T) {
+	ua := mockUnstructuredAddenda()
+	ua.AddendaLength = "0019"
+
 	err := ua.Validate()
 
-	require.EqualError(t, err, fieldError("AddendaLength", ErrFieldRequired).Error())
+	require.EqualError(t, err, fieldError("AddendaLength", ErrAddendaLengthMismatch, ua.AddendaLength).Error())
 }
 
 // TestParseUnstructuredAddendaWrongLength parses a wrong Addenda record length
@@ -71,11 +80,55 @@ func TestParseUnstructuredAddendaReaderParseError(t *testing.T) {
 
 	err := r.parseUnstructuredAddenda()
 
-	require.EqualError(t, err, r.parseError(fieldError("Addenda", ErrNonAlphanumeric, "®nstructured Addend")).Error())
+	require.EqualError(t, err, r.parseError(fieldError("Addenda", ErrNonAlphanumeric, "®nstructured Addenda")).Error())
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Addenda", ErrNonAlphanumeric, "®nstructured Addend")).Error())
+	require.EqualError(t, err, r.parseError(fieldError("Addenda", ErrNonAlphanumeric, "®nstructured Addenda")).Error())
+}
+
+// TestParseUnstructuredAddendaRepairLength repairs a wrong Addenda length when ValidateOpts allow it
+func TestParseUnstructuredAddendaRepairLength(t *testing.T) {
+	var line = "{8200}0099Unstructured Addenda"
+	r := NewReader(strings.NewReader(line))
+	r.line = line
+	r.opts = &ValidateOpts{RepairAddendaLength: true}
+
+	require.NoError(t, r.parseUnstructuredAddenda())
+
+	ua := r.currentFEDWireMessage.UnstructuredAddenda
+	require.Equal(t, "0020", ua.AddendaLength)
+	require.Equal(t, "Unstructured Addenda", ua.Addenda)
+}
+
+// TestSplitUnstructuredAddenda splits and joins addenda longer than a single tag can carry
+func TestSplitUnstructuredAddenda(t *testing.T) {
+	segment := "RMR*IV*INVOICE-NUMBER**100.00~"
+	addenda := strings.Repeat(segment, 600)
+
+	parts := SplitUnstructuredAddenda(ANSIX12format, addenda)
+	require.Len(t, parts, 3)
+	for _, ua := range parts {
+		require.NoError(t, ua.Validate())
+		require.True(t, strings.HasSuffix(ua.Addenda, "~"))
+		require.Zero(t, len(ua.Addenda)%len(segment))
+	}
+	require.Equal(t, addenda, JoinUnstructuredAddenda(parts...))
+
+	// EDIFACT segments end on an unreleased terminator
+	addenda = strings.Repeat("FTX+AAA+++a?'b'", 700)
+	parts = SplitUnstructuredAddenda(UNEDIFACTformat, addenda)
+	require.Len(t, parts, 2)
+	require.True(t, strings.HasSuffix(parts[0].Addenda, "b'"))
+	require.Equal(t, addenda, JoinUnstructuredAddenda(parts...))
+
+	// without a boundary parts are cut at the maximum length
+	parts = SplitUnstructuredAddenda(NarrativeText, strings.Repeat("x", 9000))
+	require.Len(t, parts, 2)
+	require.Equal(t, "8994", parts[0].AddendaLength)
+	require.Equal(t, "0006", parts[1].AddendaLength)
+
+	require.Empty(t, SplitUnstructuredAddenda(NarrativeText, ""))
 }
 
 // TestUnstructuredAddendaTagError validates a UnstructuredAddenda tag
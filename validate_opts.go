@@ -7,4 +7,16 @@ type ValidateOpts struct {
 
 	// AllowMissingSenderSupplied allows the senderSupplied field to be omitted.
 	AllowMissingSenderSupplied bool `json:"allowMissingSenderSupplied"`
+
+	// SkipRemittanceReconciliation skips checking that the structured remittance amounts add up
+	// to ActualAmountPaid and the amount of the transfer.
+	SkipRemittanceReconciliation bool `json:"skipRemittanceReconciliation"`
+
+	// RepairAddendaLength recomputes UnstructuredAddenda.AddendaLength from the addenda read instead of
+	// rejecting a record whose length doesn't match.
+	RepairAddendaLength bool `json:"repairAddendaLength"`
+
+	// NormalizeCharset transliterates text read into the wire character set and uppercases code fields
+	// before each tag is validated. The changes made are available from Reader.Normalizations.
+	NormalizeCharset bool `json:"normalizeCharset"`
 }
@@ -0,0 +1,318 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// Rule codes of the warnings returned by ValidateDetailed
+const (
+	// RuleTextTruncated warns that a field is longer than the tag can hold and is truncated when written
+	RuleTextTruncated = "TEXT-TRUNCATED"
+	// RuleProprietaryCodeNonStandard warns that LocalInstrument.ProprietaryCode isn't an uppercase code
+	RuleProprietaryCodeNonStandard = "PROPRIETARY-CODE-NONSTANDARD"
+	// RuleSenderReferenceReused warns that another message from the sender on the same cycle date has the same SenderReference
+	RuleSenderReferenceReused = "SENDER-REFERENCE-REUSED"
+	// RuleCurrencyUnusual warns that InstructedAmount is in an ISO 4217 X code (precious metals, SDR, testing) rather than a national currency
+	RuleCurrencyUnusual = "CURRENCY-UNUSUAL"
+)
+
+// Rule codes of the errors returned by ValidateDetailed
+const (
+	// RuleRequired is a missing mandatory tag or field
+	RuleRequired = "REQUIRED"
+	// RuleCharset is a field with characters outside the wire character set
+	RuleCharset = "CHARSET"
+	// RuleNumeric is a field with non numeric characters
+	RuleNumeric = "NUMERIC"
+	// RuleNotPermitted is a tag or field which isn't permitted alongside the others in the message
+	RuleNotPermitted = "NOT-PERMITTED"
+	// RuleLength is a field of the wrong length
+	RuleLength = "LENGTH"
+	// RuleDate is a field which isn't a valid date
+	RuleDate = "DATE"
+	// RuleInvalid is any other invalid field
+	RuleInvalid = "INVALID"
+)
+
+var (
+	// proprietaryCodeRegex matches the uppercase codes agreed between participants for the PROP local instrument
+	proprietaryCodeRegex = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 ./-]*$`)
+)
+
+// ValidationIssue is an error or warning found by ValidateDetailed
+type ValidationIssue struct {
+	// Code is the stable code of the rule broken by the field, e.g. W-3610-PC-PROPRIETARY-CODE-NONSTANDARD,
+	// see RuleCode
+	Code string `json:"code"`
+	// Tag is the tag number of the field, e.g. {3610}
+	Tag string `json:"tag,omitempty"`
+	// Field is the JSON path of the field, e.g. localInstrument.proprietaryCode
+	Field string `json:"field"`
+	// Pointer is the JSON pointer (RFC 6901) of the field within a File, e.g.
+	// /fedWireMessage/localInstrument/proprietaryCode
+	Pointer string `json:"pointer"`
+	// Rule is the rule broken, e.g. TEXT-TRUNCATED
+	Rule string `json:"rule"`
+	// Message describes the issue
+	Message string `json:"message"`
+	// Value is the value of the field
+	Value interface{} `json:"value,omitempty"`
+
+	err error
+}
+
+// ValidationResult holds the errors which make a File invalid and warnings which don't
+type ValidationResult struct {
+	Errors   []ValidationIssue `json:"errors"`
+	Warnings []ValidationIssue `json:"warnings"`
+}
+
+// Valid reports if the result has no errors. Warnings don't make a File invalid.
+func (vr *ValidationResult) Valid() bool {
+	return len(vr.Errors) == 0
+}
+
+// Err returns the first error, which is the error Validate returns, or nil when the result is valid
+func (vr *ValidationResult) Err() error {
+	if len(vr.Errors) == 0 {
+		return nil
+	}
+	return vr.Errors[0].err
+}
+
+// addError records err, which may be nil, found in fwm. Errors found by more than one check, such as a
+// tag several others require, are recorded once.
+func (vr *ValidationResult) addError(fwm *FEDWireMessage, err error) {
+	if err == nil {
+		return
+	}
+	issue := fwm.IssueFor(err)
+	for _, existing := range vr.Errors {
+		if existing.Code == issue.Code && existing.Message == issue.Message {
+			return
+		}
+	}
+	vr.Errors = append(vr.Errors, issue)
+}
+
+// IssueFor describes err, returned by Validate or Reader.Read for fwm, as a ValidationIssue with the rule
+// code, tag, field and value of the error. Only the first error of a base.ErrorList is described.
+func (fwm *FEDWireMessage) IssueFor(err error) ValidationIssue {
+	if list, ok := err.(base.ErrorList); ok && len(list) > 0 {
+		return fwm.IssueFor(list[0])
+	}
+	var je *JSONFieldError
+	if errors.As(err, &je) {
+		return je.issue()
+	}
+	tag, field := fwm.locate(err)
+	issue := ValidationIssue{
+		Code:    RuleCode(tag, field, errorRule(err)),
+		Tag:     tag,
+		Field:   field,
+		Pointer: jsonPointer(field),
+		Rule:    errorRule(err),
+		Message: err.Error(),
+		err:     err,
+	}
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		issue.Value = fe.Value
+	}
+	return issue
+}
+
+// IssuesFor describes each error of err, which may be a base.ErrorList returned by Reader.Read, like
+// IssueFor. A nil err has no issues.
+func (fwm *FEDWireMessage) IssuesFor(err error) []ValidationIssue {
+	if err == nil {
+		return nil
+	}
+	list, ok := err.(base.ErrorList)
+	if !ok {
+		return []ValidationIssue{fwm.IssueFor(err)}
+	}
+	issues := make([]ValidationIssue, 0, len(list))
+	for _, err := range list {
+		issues = append(issues, fwm.IssueFor(err))
+	}
+	return issues
+}
+
+// jsonPointer returns the JSON pointer within a File of the FEDWireMessage field at path
+func jsonPointer(path string) string {
+	if path == "" {
+		return "/fedWireMessage"
+	}
+	return "/fedWireMessage/" + strings.ReplaceAll(path, ".", "/")
+}
+
+// addWarning records a warning for field
+func (vr *ValidationResult) addWarning(field, rule string, value interface{}, format string, args ...interface{}) {
+	tag := pathTag(field)
+	vr.Warnings = append(vr.Warnings, ValidationIssue{
+		Code:    RuleCode(tag, field, rule),
+		Tag:     tag,
+		Field:   field,
+		Pointer: jsonPointer(field),
+		Rule:    rule,
+		Message: fmt.Sprintf(format, args...),
+		Value:   value,
+	})
+}
+
+// errorRule returns the rule code for err
+func errorRule(err error) string {
+	var bfc ErrBusinessFunctionCodeProperty
+	var prop ErrInvalidPropertyForProperty
+	var length FieldWrongLengthErr
+	switch {
+	case errors.Is(err, ErrFieldRequired), errors.Is(err, ErrFieldInclusion), errors.Is(err, ErrConstructor):
+		return RuleRequired
+	case errors.Is(err, ErrNonAlphanumeric), errors.Is(err, ErrAddendaCharset):
+		return RuleCharset
+	case errors.Is(err, ErrNonNumeric):
+		return RuleNumeric
+	case errors.Is(err, ErrNotPermitted), errors.Is(err, ErrLocalInstrumentNotPermitted), errors.Is(err, ErrInvalidProperty),
+		errors.As(err, &bfc), errors.As(err, &prop):
+		return RuleNotPermitted
+	case errors.Is(err, ErrValidLength), errors.Is(err, ErrNormalizedLength), errors.As(err, &length):
+		return RuleLength
+	case errors.Is(err, ErrValidDate), errors.Is(err, ErrValidMonth), errors.Is(err, ErrValidDay),
+		errors.Is(err, ErrValidYear), errors.Is(err, ErrValidCentury):
+		return RuleDate
+	}
+	return RuleInvalid
+}
+
+// ValidateDetailed validates f like Validate but reports every error found rather than the first, along
+// with warnings about things that don't make f invalid. Files already sent are checked for a reused
+// SenderReference.
+func (f *File) ValidateDetailed(sent ...*File) *ValidationResult {
+	return f.ValidateDetailedWithContext(context.Background(), sent...)
+}
+
+// validateDetailed finds the errors and warnings of ValidateDetailed
+func (f *File) validateDetailed(sent ...*File) *ValidationResult {
+	result := &ValidationResult{
+		Errors:   []ValidationIssue{},
+		Warnings: []ValidationIssue{},
+	}
+	fwm := &f.FEDWireMessage
+	if err := fwm.mandatoryFields(); err != nil {
+		// the other checks rely on the mandatory tags
+		result.addError(fwm, err)
+	} else {
+		for _, validate := range fwm.validations() {
+			result.addError(fwm, validate())
+		}
+	}
+
+	fwm.warnTruncatedText(result)
+	fwm.warnProprietaryCode(result)
+	fwm.warnInstructedAmountCurrency(result)
+	for _, other := range sent {
+		if other != nil && other.ID != f.ID {
+			fwm.warnSenderReferenceReused(result, &other.FEDWireMessage, other.ID)
+		}
+	}
+	return result
+}
+
+// warnTruncatedText warns about fields longer than their tag can hold
+func (fwm *FEDWireMessage) warnTruncatedText(result *ValidationResult) {
+	v := reflect.ValueOf(fwm).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Ptr || field.IsNil() {
+			continue
+		}
+		written, ok := writtenTag(field.Interface())
+		if !ok {
+			continue
+		}
+		walkStrings(field.Elem(), jsonName(v.Type().Field(i)), nil, func(path string, index []int, _ reflect.StructField, value reflect.Value) {
+			kept := written.FieldByIndex(index).String()
+			if isTruncated(value.String(), kept) {
+				result.addWarning(path, RuleTextTruncated, strings.TrimRight(value.String(), " "), "is truncated to %q", strings.TrimRight(kept, " "))
+			}
+		})
+	}
+}
+
+// writtenTag returns tag, a pointer to a tag struct, parsed back from its String to show the values
+// kept when it's written
+func writtenTag(tag interface{}) (reflect.Value, bool) {
+	t, ok := tag.(interface {
+		Parse(string) error
+		String() string
+	})
+	if !ok {
+		return reflect.Value{}, false
+	}
+	written := reflect.New(reflect.TypeOf(tag).Elem())
+	if err := written.Interface().(interface{ Parse(string) error }).Parse(t.String()); err != nil {
+		return reflect.Value{}, false
+	}
+	return written.Elem(), true
+}
+
+// isTruncated reports if kept, a field of a tag from writtenTag, is value cut short
+func isTruncated(value, kept string) bool {
+	value, kept = strings.TrimRight(value, " "), strings.TrimRight(kept, " ")
+	return len(kept) < len(value) && strings.HasPrefix(value, kept)
+}
+
+// warnProprietaryCode warns when LocalInstrument.ProprietaryCode isn't an uppercase code
+func (fwm *FEDWireMessage) warnProprietaryCode(result *ValidationResult) {
+	if fwm.LocalInstrument == nil || fwm.LocalInstrument.ProprietaryCode == "" {
+		return
+	}
+	if code := fwm.LocalInstrument.ProprietaryCode; !proprietaryCodeRegex.MatchString(code) {
+		result.addWarning("localInstrument.proprietaryCode", RuleProprietaryCodeNonStandard, code,
+			"is not an uppercase proprietary code")
+	}
+}
+
+// warnInstructedAmountCurrency warns when InstructedAmount isn't in a national currency
+func (fwm *FEDWireMessage) warnInstructedAmountCurrency(result *ValidationResult) {
+	if fwm.InstructedAmount == nil {
+		return
+	}
+	if code := fwm.InstructedAmount.CurrencyCode; strings.HasPrefix(code, "X") {
+		result.addWarning("instructedAmount.currencyCode", RuleCurrencyUnusual, code,
+			"is not a national currency")
+	}
+}
+
+// warnSenderReferenceReused warns when other, sent by the same sender on the same cycle date, has the
+// same SenderReference as fwm
+func (fwm *FEDWireMessage) warnSenderReferenceReused(result *ValidationResult, other *FEDWireMessage, otherID string) {
+	if fwm.SenderReference == nil || other.SenderReference == nil || fwm.SenderReference.SenderReference == "" {
+		return
+	}
+	if fwm.SenderReference.SenderReference != other.SenderReference.SenderReference {
+		return
+	}
+	if fwm.InputMessageAccountabilityData == nil || other.InputMessageAccountabilityData == nil ||
+		fwm.InputMessageAccountabilityData.InputCycleDate != other.InputMessageAccountabilityData.InputCycleDate {
+		return
+	}
+	if fwm.SenderDepositoryInstitution != nil && other.SenderDepositoryInstitution != nil &&
+		fwm.SenderDepositoryInstitution.SenderABANumber != other.SenderDepositoryInstitution.SenderABANumber {
+		return
+	}
+	result.addWarning("senderReference.senderReference", RuleSenderReferenceReused, fwm.SenderReference.SenderReference,
+		"is also used by %s on %s", otherID, fwm.InputMessageAccountabilityData.InputCycleDate)
+}
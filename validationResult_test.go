@@ -0,0 +1,169 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockValidatedFile creates a valid CustomerTransfer File
+func mockValidatedFile() *File {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.SenderReference = mockSenderReference()
+	file := NewFile()
+	file.AddFEDWireMessage(fwm)
+	return file
+}
+
+func TestFile_ValidateDetailed(t *testing.T) {
+	file := mockValidatedFile()
+	require.NoError(t, file.Validate())
+
+	result := file.ValidateDetailed()
+	require.True(t, result.Valid())
+	require.NoError(t, result.Err())
+	require.Empty(t, result.Errors)
+	require.Empty(t, result.Warnings)
+}
+
+func TestFile_ValidateDetailedErrors(t *testing.T) {
+	file := mockValidatedFile()
+	file.FEDWireMessage.LocalInstrument = mockLocalInstrument()
+	file.FEDWireMessage.BeneficiaryIntermediaryFI = mockBeneficiaryIntermediaryFI()
+	file.FEDWireMessage.BeneficiaryFI = nil
+
+	result := file.ValidateDetailed()
+	require.False(t, result.Valid())
+	require.Equal(t, file.Validate(), result.Err())
+	require.Equal(t, []ValidationIssue{
+		{
+			Code:    "W-3610-TAG-NOT-PERMITTED",
+			Tag:     TagLocalInstrument,
+			Field:   "localInstrument",
+			Pointer: "/fedWireMessage/localInstrument",
+			Rule:    RuleNotPermitted,
+			Message: fieldError("LocalInstrument", ErrLocalInstrumentNotPermitted).Error(),
+			err:     result.Errors[0].err,
+		},
+		{
+			Code:    "W-4100-TAG-REQUIRED",
+			Tag:     TagBeneficiaryFI,
+			Field:   "beneficiaryFI",
+			Pointer: "/fedWireMessage/beneficiaryFI",
+			Rule:    RuleRequired,
+			Message: fieldError("BeneficiaryFI", ErrFieldRequired).Error(),
+			err:     result.Errors[1].err,
+		},
+	}, result.Errors)
+
+	// the other checks are skipped when a mandatory tag is missing
+	file.FEDWireMessage.Amount = nil
+	result = file.ValidateDetailed()
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, RuleRequired, result.Errors[0].Rule)
+	require.Equal(t, file.Validate(), result.Err())
+}
+
+func TestFile_ValidateDetailedWarnings(t *testing.T) {
+	file := mockValidatedFile()
+	fwm := &file.FEDWireMessage
+	fwm.Beneficiary.Personal.Name = strings.Repeat("A", 40)
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.LocalInstrument = mockLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = ProprietaryLocalInstrumentCode
+	fwm.LocalInstrument.ProprietaryCode = "prop code"
+	fwm.InstructedAmount = mockInstructedAmount()
+	fwm.InstructedAmount.CurrencyCode = "XAU"
+	file.ID = "current"
+
+	sent := mockValidatedFile()
+	sent.ID = "earlier"
+	other := mockValidatedFile()
+	other.ID = "other-day"
+	other.FEDWireMessage.InputMessageAccountabilityData.InputCycleDate = "20190101"
+
+	result := file.ValidateDetailed(sent, other, file)
+	require.Equal(t, []ValidationIssue{
+		{
+			Code:    "W-4200-N-TEXT-TRUNCATED",
+			Tag:     TagBeneficiary,
+			Field:   "beneficiary.personal.name",
+			Pointer: "/fedWireMessage/beneficiary/personal/name",
+			Rule:    RuleTextTruncated,
+			Message: `is truncated to "` + strings.Repeat("A", 35) + `"`,
+			Value:   strings.Repeat("A", 40),
+		},
+		{
+			Code:    "W-3610-PC-PROPRIETARY-CODE-NONSTANDARD",
+			Tag:     TagLocalInstrument,
+			Field:   "localInstrument.proprietaryCode",
+			Pointer: "/fedWireMessage/localInstrument/proprietaryCode",
+			Rule:    RuleProprietaryCodeNonStandard,
+			Message: "is not an uppercase proprietary code",
+			Value:   "prop code",
+		},
+		{
+			Code:    "W-3710-CC-CURRENCY-UNUSUAL",
+			Tag:     TagInstructedAmount,
+			Field:   "instructedAmount.currencyCode",
+			Pointer: "/fedWireMessage/instructedAmount/currencyCode",
+			Rule:    RuleCurrencyUnusual,
+			Message: "is not a national currency",
+			Value:   "XAU",
+		},
+		{
+			Code:    "W-3320-SR-SENDER-REFERENCE-REUSED",
+			Tag:     TagSenderReference,
+			Field:   "senderReference.senderReference",
+			Pointer: "/fedWireMessage/senderReference/senderReference",
+			Rule:    RuleSenderReferenceReused,
+			Message: "is also used by earlier on " + fwm.InputMessageAccountabilityData.InputCycleDate,
+			Value:   "Sender Reference",
+		},
+	}, result.Warnings)
+}
+
+func TestFEDWireMessage_IssueFor(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	bs = bytes.Replace(bs, []byte("{4200}31234*"), []byte("{4200}312®4*"), 1)
+
+	file, err := NewReader(bytes.NewReader(bs)).Read()
+	require.Error(t, err)
+
+	issue := file.FEDWireMessage.IssueFor(err)
+	require.Equal(t, "W-4200-I-CHARSET", issue.Code)
+	require.Equal(t, TagBeneficiary, issue.Tag)
+	require.Equal(t, "beneficiary.personal.identifier", issue.Field)
+	require.Equal(t, "/fedWireMessage/beneficiary/personal/identifier", issue.Pointer)
+	require.Equal(t, RuleCharset, issue.Rule)
+	require.Equal(t, "12®4", issue.Value)
+}
+
+func TestFEDWireMessage_IssuesFor(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	bs = bytes.Replace(bs, []byte("{4200}31234*"), []byte("{4200}312®4*"), 1)
+	bs = bytes.Replace(bs, []byte("{2000}"), []byte("{2000}ABC"), 1)
+
+	file, err := NewReader(bytes.NewReader(bs)).Read()
+	require.Error(t, err)
+
+	issues := file.FEDWireMessage.IssuesFor(err)
+	require.Len(t, issues, 2)
+	require.Equal(t, TagAmount, issues[0].Tag)
+	require.Equal(t, "/fedWireMessage/amount", issues[0].Pointer)
+	require.Equal(t, "/fedWireMessage/beneficiary/personal/identifier", issues[1].Pointer)
+
+	require.Nil(t, file.FEDWireMessage.IssuesFor(nil))
+}
@@ -10,6 +10,7 @@ import (
 	"unicode/utf8"
 
 	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
 )
 
 var (
@@ -20,6 +21,12 @@ var (
 	// NOTE: This applies to all Fedwire tags except {8200} Unstructured Addenda Info
 	alphanumericRegex = regexp.MustCompile(`[^ \w.?!,;:_@&/\\'"\x60~()<>$#%+-=]+`)
 
+	// Character sets permitted in {8200} Unstructured Addenda Info depending on LocalInstrumentCode.
+	// x12CharsetRegex matches outside the X12 basic and extended character sets (ANSIX12format and
+	// STP820format), mxCharsetRegex outside the SWIFT MX ISO 20022 character set (the other formats).
+	x12CharsetRegex = regexp.MustCompile(`[^ A-Za-z0-9!"&'()*+,\-./:;?=%@\[\]_{}\\|<>~#$^]`)
+	mxCharsetRegex  = regexp.MustCompile(`[^ A-Za-z0-9/\-?:().,'+!#$%&*=^_\x60{|}~";<>@\[\\\]]`)
+
 	numericRegex = regexp.MustCompile(`[^0-9]`)
 	amountRegex  = regexp.MustCompile("[^0-9,.]")
 )
@@ -35,6 +42,23 @@ func (v *validator) isAlphanumeric(s string) error {
 	return nil
 }
 
+// isAddendaCharset checks if a string only contains characters permitted in {8200} Unstructured Addenda
+// Info for localInstrumentCode. ANSIX12format and STP820format use the X12 character set, other codes
+// the SWIFT MX ISO 20022 character set.
+func (v *validator) isAddendaCharset(s, localInstrumentCode string) error {
+	switch localInstrumentCode {
+	case ANSIX12format, STP820format:
+		if x12CharsetRegex.MatchString(s) {
+			return ErrAddendaCharset
+		}
+	default:
+		if mxCharsetRegex.MatchString(s) {
+			return ErrAddendaCharset
+		}
+	}
+	return nil
+}
+
 // isNumeric checks if a string only contains ASCII numeric (0-9) characters
 func (v *validator) isNumeric(s string) error {
 	if numericRegex.MatchString(s) {
@@ -410,6 +434,18 @@ func (v *validator) isDay(m string, d string) error {
 	return ErrValidDay
 }
 
+// isCountryCode checks if s is an assigned two letter ISO 3166 country code
+func (v *validator) isCountryCode(s string) error {
+	if len(s) != 2 || strings.ToUpper(s) != s {
+		return ErrNonCountryCode
+	}
+	region, err := language.ParseRegion(s)
+	if err != nil || !region.IsCountry() {
+		return ErrNonCountryCode
+	}
+	return nil
+}
+
 // validateDate will return the incoming string only if it matches a valid CCYYMMDD
 // date format. (C=Century, Y=Year, M=Month, D=Day)
 func (v *validator) validateDate(s string) error {
@@ -0,0 +1,20 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package wirepb contains the Protobuf messages of wire.File and every tag within it, along with
+// WireService, the gRPC service of the wire server.
+//
+// wire.proto is generated from the structs of the wire package. Fields are named after the Go fields
+// and keep the JSON names of the wire package, so protojson reads and writes the same JSON as
+// encoding/json does for wire.File. wire.avsc and wire.schema.json, the Avro and JSON schemas of the
+// same structs, are generated with it.
+//
+// Field numbers are stable across releases: regenerating keeps the number of every field, new fields
+// take the next number of their message, and removed fields are reserved rather than reused. Avro
+// fields are resolved by name and every string, boolean and optional record has a default, so readers
+// and writers of different releases remain compatible.
+package wirepb
+
+//go:generate go run gen.go
+//go:generate protoc -I=.. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative ../wirepb/wire.proto ../wirepb/service.proto
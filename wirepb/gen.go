@@ -0,0 +1,400 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// gen writes wire.proto, wire.avsc and wire.schema.json, the Protobuf, Avro and JSON schemas of wire.File and every tag within
+// it, from the structs of the wire package and their doc comments.
+//
+// Field numbers are read from the wire.proto being replaced so they never change: new fields take the
+// next number of their message, and the numbers and names of removed fields are reserved.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	protoFile = "wire.proto"
+	avroFile  = "wire.avsc"
+	jsonFile  = "wire.schema.json"
+
+	// avroNamespace matches the Protobuf package
+	avroNamespace = "moov.wire.v1"
+)
+
+const header = `// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Code generated by gen.go from the structs of the wire package. DO NOT EDIT.
+//
+// Field numbers are stable across releases. Regenerating keeps the number of every field, new fields
+// take the next number of their message, and the numbers and names of removed fields are reserved.
+
+syntax = "proto3";
+
+package moov.wire.v1;
+
+option go_package = "github.com/moov-io/wire/wirepb";
+`
+
+func main() {
+	docs, err := readDocs("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+	numbers, err := readNumbers(protoFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := &generator{
+		docs:    docs,
+		numbers: numbers,
+		seen:    make(map[reflect.Type]bool),
+		defs:    make(map[string]interface{}),
+	}
+	schema := g.message(reflect.TypeOf(wire.File{}))
+	schema.Namespace = avroNamespace
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, m := range g.messages {
+		buf.WriteString("\n")
+		buf.WriteString(m)
+	}
+	if err := os.WriteFile(protoFile, buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	bs, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(avroFile, append(bs, '\n'), 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	bs, err = json.MarshalIndent(map[string]interface{}{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"title":       "File",
+		"description": oneLine(docs["File"]),
+		"$ref":        "#/$defs/File",
+		"$defs":       g.defs,
+	}, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(jsonFile, append(bs, '\n'), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// messageNumbers are the field numbers of a message in wire.proto
+type messageNumbers struct {
+	fields   map[string]int
+	reserved []string // reserved numbers and quoted names
+	max      int
+}
+
+var (
+	messageLine  = regexp.MustCompile(`^message (\w+) \{`)
+	fieldLine    = regexp.MustCompile(`^  \w+ (\w+) = (\d+)`)
+	reservedLine = regexp.MustCompile(`^  reserved (.+);`)
+)
+
+// readNumbers returns the field numbers of each message in path, or none when path doesn't exist
+func readNumbers(path string) (map[string]*messageNumbers, error) {
+	out := make(map[string]*messageNumbers)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return out, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var current *messageNumbers
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := messageLine.FindStringSubmatch(line); m != nil {
+			current = &messageNumbers{fields: make(map[string]int)}
+			out[m[1]] = current
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if m := fieldLine.FindStringSubmatch(line); m != nil {
+			n, _ := strconv.Atoi(m[2])
+			current.fields[m[1]] = n
+			if n > current.max {
+				current.max = n
+			}
+		}
+		if m := reservedLine.FindStringSubmatch(line); m != nil {
+			for _, r := range strings.Split(m[1], ",") {
+				r = strings.TrimSpace(r)
+				current.reserved = append(current.reserved, r)
+				if n, err := strconv.Atoi(r); err == nil && n > current.max {
+					current.max = n
+				}
+			}
+		}
+	}
+	return out, scanner.Err()
+}
+
+// avroRecord is an Avro record schema
+type avroRecord struct {
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace,omitempty"`
+	Doc       string      `json:"doc,omitempty"`
+	Fields    []avroField `json:"fields"`
+}
+
+type avroField struct {
+	Name    string          `json:"name"`
+	Doc     string          `json:"doc,omitempty"`
+	Type    interface{}     `json:"type"`
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+// generator renders the schemas of each struct reachable from wire.File. Messages are rendered once in
+// the order they're found, Avro records are defined where they're first used and JSON Schemas are
+// collected in defs, keyed by type name.
+type generator struct {
+	docs     map[string]string
+	numbers  map[string]*messageNumbers
+	seen     map[reflect.Type]bool
+	messages []string
+	defs     map[string]interface{}
+}
+
+// jsonSchema returns the JSON Schema of a field described by doc
+func jsonSchema(doc string, schema map[string]interface{}) map[string]interface{} {
+	if doc = oneLine(doc); doc != "" {
+		schema["description"] = doc
+	}
+	return schema
+}
+
+func (g *generator) message(t reflect.Type) *avroRecord {
+	g.seen[t] = true
+
+	// messages are listed in the order they're found, with nested messages after their parent
+	index := len(g.messages)
+	g.messages = append(g.messages, "")
+
+	numbers := g.numbers[t.Name()]
+	if numbers == nil {
+		numbers = &messageNumbers{fields: make(map[string]int)}
+	}
+	next := numbers.max
+	used := make(map[string]bool)
+
+	record := &avroRecord{
+		Type: "record",
+		Name: t.Name(),
+		Doc:  oneLine(g.docs[t.Name()]),
+	}
+	properties := make(map[string]interface{})
+	g.defs[t.Name()] = jsonSchema(g.docs[t.Name()], map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	})
+
+	var buf strings.Builder
+	writeComment(&buf, "", g.docs[t.Name()])
+	fmt.Fprintf(&buf, "message %s {\n", t.Name())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() || sf.Anonymous || sf.Tag.Get("json") == "-" {
+			continue
+		}
+		field := avroField{
+			Name: jsonName(sf),
+			Doc:  oneLine(g.docs[t.Name()+"."+sf.Name]),
+		}
+		var kind string
+		property := make(map[string]interface{})
+		switch ft := indirect(sf.Type); ft.Kind() {
+		case reflect.String:
+			kind = "string"
+			field.Type, field.Default = "string", json.RawMessage(`""`)
+			property["type"] = "string"
+		case reflect.Bool:
+			kind = "bool"
+			field.Type, field.Default = "boolean", json.RawMessage(`false`)
+			property["type"] = "boolean"
+		case reflect.Struct:
+			kind = ft.Name()
+			var nested interface{} = ft.Name()
+			if !g.seen[ft] {
+				nested = g.message(ft)
+			}
+			ref := map[string]interface{}{"$ref": "#/$defs/" + ft.Name()}
+			if sf.Type.Kind() == reflect.Ptr {
+				field.Type, field.Default = []interface{}{"null", nested}, json.RawMessage(`null`)
+				property["anyOf"] = []interface{}{ref, map[string]interface{}{"type": "null"}}
+			} else {
+				field.Type = nested
+				property = ref
+			}
+		default:
+			log.Fatalf("%s.%s: unsupported type %s", t.Name(), sf.Name, sf.Type)
+		}
+		record.Fields = append(record.Fields, field)
+		properties[jsonName(sf)] = jsonSchema(g.docs[t.Name()+"."+sf.Name], property)
+
+		name := snakeCase(sf.Name)
+		used[name] = true
+		number, ok := numbers.fields[name]
+		if !ok {
+			next++
+			number = next
+		}
+		var options string
+		if jsonName := jsonName(sf); jsonName != lowerCamel(name) {
+			options = fmt.Sprintf(" [json_name = %q]", jsonName)
+		}
+		if len(used) > 1 {
+			buf.WriteString("\n")
+		}
+		writeComment(&buf, "  ", g.docs[t.Name()+"."+sf.Name])
+		fmt.Fprintf(&buf, "  %s %s = %d%s;\n", kind, name, number, options)
+	}
+
+	// fields which were removed keep their number and name out of use
+	reserved := append([]string{}, numbers.reserved...)
+	for name, number := range numbers.fields {
+		if !used[name] {
+			reserved = append(reserved, strconv.Itoa(number), strconv.Quote(name))
+		}
+	}
+	sort.Strings(reserved)
+	if len(reserved) > 0 {
+		fmt.Fprintf(&buf, "\n  reserved %s;\n", strings.Join(reserved, ", "))
+	}
+	buf.WriteString("}\n")
+	g.messages[index] = buf.String()
+	return record
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+func jsonName(sf reflect.StructField) string {
+	if name, _, _ := strings.Cut(sf.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return sf.Name
+}
+
+// snakeCase converts a Go field name to a Protobuf field name, e.g. FIReceiverFI to fi_receiver_fi
+func snakeCase(name string) string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		upper := unicode.IsUpper(runes[i])
+		prevUpper := unicode.IsUpper(runes[i-1])
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if upper && (!prevUpper || nextLower) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(runes[start:]))
+	return strings.ToLower(strings.Join(words, "_"))
+}
+
+// lowerCamel returns the JSON name protoc gives a field called name
+func lowerCamel(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func writeComment(buf *strings.Builder, indent, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		buf.WriteString(strings.TrimRight(indent+"// "+line, " "))
+		buf.WriteString("\n")
+	}
+}
+
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// readDocs returns the doc comments of the types in dir, keyed by type name, and of their fields,
+// keyed by type and field name
+func readDocs(dir string) (map[string]string, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	docs := make(map[string]string)
+	ast.Inspect(pkgs["wire"], func(n ast.Node) bool {
+		decl, ok := n.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			return true
+		}
+		for _, spec := range decl.Specs {
+			ts := spec.(*ast.TypeSpec)
+			doc := ts.Doc
+			if doc == nil && len(decl.Specs) == 1 {
+				doc = decl.Doc
+			}
+			docs[ts.Name.Name] = doc.Text()
+
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				for _, name := range field.Names {
+					docs[ts.Name.Name+"."+name.Name] = field.Doc.Text()
+				}
+			}
+		}
+		return false
+	})
+	return docs, nil
+}
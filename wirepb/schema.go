@@ -0,0 +1,21 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wirepb
+
+import (
+	_ "embed"
+)
+
+// AvroSchema is the Avro schema of wire.File, generated alongside wire.proto. Records are named after
+// the structs of the wire package in the moov.wire.v1 namespace and fields keep their JSON names.
+//
+//go:embed wire.avsc
+var AvroSchema string
+
+// JSONSchema is the JSON Schema (draft 2020-12) of wire.File, generated alongside wire.proto. It rejects
+// the keys and values wire.FileFromJSONStrict does.
+//
+//go:embed wire.schema.json
+var JSONSchema string
@@ -0,0 +1,1000 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: wirepb/service.proto
+
+package wirepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Format is the format a file is converted to
+type Format int32
+
+const (
+	// FORMAT_UNSPECIFIED is the same as FORMAT_FIXED
+	Format_FORMAT_UNSPECIFIED Format = 0
+	// FORMAT_FIXED is Fedwire text with fixed length fields
+	Format_FORMAT_FIXED Format = 1
+	// FORMAT_VARIABLE is Fedwire text with variable length fields
+	Format_FORMAT_VARIABLE Format = 2
+	// FORMAT_FILE is a File
+	Format_FORMAT_FILE Format = 3
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_FIXED",
+		2: "FORMAT_VARIABLE",
+		3: "FORMAT_FILE",
+	}
+	Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_FIXED":       1,
+		"FORMAT_VARIABLE":    2,
+		"FORMAT_FILE":        3,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_wirepb_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_wirepb_service_proto_enumTypes[0]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_wirepb_service_proto_rawDescGZIP(), []int{0}
+}
+
+type CreateFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Content:
+	//	*CreateFileRequest_File
+	//	*CreateFileRequest_Text
+	Content isCreateFileRequest_Content `protobuf_oneof:"content"`
+	Options *ValidateOpts               `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *CreateFileRequest) Reset() {
+	*x = CreateFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wirepb_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileRequest) ProtoMessage() {}
+
+func (x *CreateFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wirepb_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileRequest.ProtoReflect.Descriptor instead.
+func (*CreateFileRequest) Descriptor() ([]byte, []int) {
+	return file_wirepb_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *CreateFileRequest) GetContent() isCreateFileRequest_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (x *CreateFileRequest) GetFile() *File {
+	if x, ok := x.GetContent().(*CreateFileRequest_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *CreateFileRequest) GetText() string {
+	if x, ok := x.GetContent().(*CreateFileRequest_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CreateFileRequest) GetOptions() *ValidateOpts {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type isCreateFileRequest_Content interface {
+	isCreateFileRequest_Content()
+}
+
+type CreateFileRequest_File struct {
+	// file is a file to create
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type CreateFileRequest_Text struct {
+	// text is a file in Fedwire text to read
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+func (*CreateFileRequest_File) isCreateFileRequest_Content() {}
+
+func (*CreateFileRequest_Text) isCreateFileRequest_Content() {}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// redact masks party names, addresses, account numbers and free text
+	Redact bool `protobuf:"varint,2,opt,name=redact,proto3" json:"redact,omitempty"`
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wirepb_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wirepb_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_wirepb_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetRedact() bool {
+	if x != nil {
+		return x.Redact
+	}
+	return false
+}
+
+type ValidateFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Content:
+	//	*ValidateFileRequest_File
+	//	*ValidateFileRequest_Text
+	//	*ValidateFileRequest_FileId
+	Content isValidateFileRequest_Content `protobuf_oneof:"content"`
+	Options *ValidateOpts                 `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// reference is returned in the ValidationResult so results of ValidateFiles can be matched to requests
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *ValidateFileRequest) Reset() {
+	*x = ValidateFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wirepb_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFileRequest) ProtoMessage() {}
+
+func (x *ValidateFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wirepb_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFileRequest.ProtoReflect.Descriptor instead.
+func (*ValidateFileRequest) Descriptor() ([]byte, []int) {
+	return file_wirepb_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *ValidateFileRequest) GetContent() isValidateFileRequest_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (x *ValidateFileRequest) GetFile() *File {
+	if x, ok := x.GetContent().(*ValidateFileRequest_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ValidateFileRequest) GetText() string {
+	if x, ok := x.GetContent().(*ValidateFileRequest_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ValidateFileRequest) GetFileId() string {
+	if x, ok := x.GetContent().(*ValidateFileRequest_FileId); ok {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ValidateFileRequest) GetOptions() *ValidateOpts {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ValidateFileRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type isValidateFileRequest_Content interface {
+	isValidateFileRequest_Content()
+}
+
+type ValidateFileRequest_File struct {
+	// file is a file to validate
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type ValidateFileRequest_Text struct {
+	// text is a file in Fedwire text to read and validate
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type ValidateFileRequest_FileId struct {
+	// file_id is the ID of a stored file to validate
+	FileId string `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3,oneof"`
+}
+
+func (*ValidateFileRequest_File) isValidateFileRequest_Content() {}
+
+func (*ValidateFileRequest_Text) isValidateFileRequest_Content() {}
+
+func (*ValidateFileRequest_FileId) isValidateFileRequest_Content() {}
+
+// ValidationIssue is an error or warning about a field
+type ValidationIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is the stable code of the rule broken by the field, e.g. W-3600-BFC-INVALID
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// tag is the tag number of the field, e.g. {3600}
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// field is the JSON path of the field, e.g. businessFunctionCode.businessFunctionCode
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// pointer is the JSON pointer of the field within a File, e.g. /fedWireMessage/businessFunctionCode/businessFunctionCode
+	Pointer string `protobuf:"bytes,4,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	// rule is the rule broken by the field, e.g. INVALID
+	Rule    string `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// value is the value of the field
+	Value string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ValidationIssue) Reset() {
+	*x = ValidationIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wirepb_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationIssue) ProtoMessage() {}
+
+func (x *ValidationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_wirepb_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationIssue.ProtoReflect.Descriptor instead.
+func (*ValidationIssue) Descriptor() ([]byte, []int) {
+	return file_wirepb_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidationIssue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetPointer() string {
+	if x != nil {
+		return x.Pointer
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ValidationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reference is the reference of the request
+	Reference string             `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Valid     bool               `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors    []*ValidationIssue `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	// warnings are about things which don't make the file invalid, such as text truncated to fit a tag
+	Warnings []*ValidationIssue `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *ValidationResult) Reset() {
+	*x = ValidationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wirepb_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationResult) ProtoMessage() {}
+
+func (x *ValidationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wirepb_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationResult.ProtoReflect.Descriptor instead.
+func (*ValidationResult) Descriptor() ([]byte, []int) {
+	return file_wirepb_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidationResult) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ValidationResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidationResult) GetErrors() []*ValidationIssue {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidationResult) GetWarnings() []*ValidationIssue {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ConvertFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Content:
+	//	*ConvertFileRequest_File
+	//	*ConvertFileRequest_Text
+	Content isConvertFileRequest_Content `protobuf_oneof:"content"`
+	Options *ValidateOpts                `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	Format  Format                       `protobuf:"varint,4,opt,name=format,proto3,enum=moov.wire.v1.Format" json:"format,omitempty"`
+	// omit_newlines writes Fedwire text without a newline after each tag
+	OmitNewlines bool `protobuf:"varint,5,opt,name=omit_newlines,json=omitNewlines,proto3" json:"omit_newlines,omitempty"`
+}
+
+func (x *ConvertFileRequest) Reset() {
+	*x = ConvertFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wirepb_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertFileRequest) ProtoMessage() {}
+
+func (x *ConvertFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wirepb_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertFileRequest.ProtoReflect.Descriptor instead.
+func (*ConvertFileRequest) Descriptor() ([]byte, []int) {
+	return file_wirepb_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *ConvertFileRequest) GetContent() isConvertFileRequest_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (x *ConvertFileRequest) GetFile() *File {
+	if x, ok := x.GetContent().(*ConvertFileRequest_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ConvertFileRequest) GetText() string {
+	if x, ok := x.GetContent().(*ConvertFileRequest_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ConvertFileRequest) GetOptions() *ValidateOpts {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ConvertFileRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ConvertFileRequest) GetOmitNewlines() bool {
+	if x != nil {
+		return x.OmitNewlines
+	}
+	return false
+}
+
+type isConvertFileRequest_Content interface {
+	isConvertFileRequest_Content()
+}
+
+type ConvertFileRequest_File struct {
+	// file is a file to convert
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type ConvertFileRequest_Text struct {
+	// text is a file in Fedwire text to convert
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+func (*ConvertFileRequest_File) isConvertFileRequest_Content() {}
+
+func (*ConvertFileRequest_Text) isConvertFileRequest_Content() {}
+
+type ConvertFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Content:
+	//	*ConvertFileResponse_File
+	//	*ConvertFileResponse_Text
+	Content isConvertFileResponse_Content `protobuf_oneof:"content"`
+}
+
+func (x *ConvertFileResponse) Reset() {
+	*x = ConvertFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wirepb_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertFileResponse) ProtoMessage() {}
+
+func (x *ConvertFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wirepb_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertFileResponse.ProtoReflect.Descriptor instead.
+func (*ConvertFileResponse) Descriptor() ([]byte, []int) {
+	return file_wirepb_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *ConvertFileResponse) GetContent() isConvertFileResponse_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (x *ConvertFileResponse) GetFile() *File {
+	if x, ok := x.GetContent().(*ConvertFileResponse_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ConvertFileResponse) GetText() string {
+	if x, ok := x.GetContent().(*ConvertFileResponse_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+type isConvertFileResponse_Content interface {
+	isConvertFileResponse_Content()
+}
+
+type ConvertFileResponse_File struct {
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type ConvertFileResponse_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+func (*ConvertFileResponse_File) isConvertFileResponse_Content() {}
+
+func (*ConvertFileResponse_Text) isConvertFileResponse_Content() {}
+
+var File_wirepb_service_proto protoreflect.FileDescriptor
+
+var file_wirepb_service_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x77, 0x69, 0x72, 0x65, 0x70, 0x62, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x77, 0x69, 0x72, 0x65, 0x70, 0x62, 0x2f, 0x77, 0x69, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x34, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x41,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x64, 0x61, 0x63,
+	0x74, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77,
+	0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74,
+	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xb8, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x39, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xe8, 0x01, 0x0a,
+	0x12, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x73,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x76,
+	0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6d, 0x69, 0x74, 0x5f,
+	0x6e, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x6f, 0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x42, 0x09,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2a, 0x58, 0x0a, 0x06, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x49, 0x4c,
+	0x45, 0x10, 0x03, 0x32, 0x8c, 0x03, 0x0a, 0x0b, 0x57, 0x69, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77,
+	0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x28, 0x01,
+	0x30, 0x01, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x6f, 0x6f, 0x76, 0x2d, 0x69, 0x6f, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x2f, 0x77, 0x69,
+	0x72, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_wirepb_service_proto_rawDescOnce sync.Once
+	file_wirepb_service_proto_rawDescData = file_wirepb_service_proto_rawDesc
+)
+
+func file_wirepb_service_proto_rawDescGZIP() []byte {
+	file_wirepb_service_proto_rawDescOnce.Do(func() {
+		file_wirepb_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_wirepb_service_proto_rawDescData)
+	})
+	return file_wirepb_service_proto_rawDescData
+}
+
+var file_wirepb_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wirepb_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_wirepb_service_proto_goTypes = []interface{}{
+	(Format)(0),                 // 0: moov.wire.v1.Format
+	(*CreateFileRequest)(nil),   // 1: moov.wire.v1.CreateFileRequest
+	(*GetFileRequest)(nil),      // 2: moov.wire.v1.GetFileRequest
+	(*ValidateFileRequest)(nil), // 3: moov.wire.v1.ValidateFileRequest
+	(*ValidationIssue)(nil),     // 4: moov.wire.v1.ValidationIssue
+	(*ValidationResult)(nil),    // 5: moov.wire.v1.ValidationResult
+	(*ConvertFileRequest)(nil),  // 6: moov.wire.v1.ConvertFileRequest
+	(*ConvertFileResponse)(nil), // 7: moov.wire.v1.ConvertFileResponse
+	(*File)(nil),                // 8: moov.wire.v1.File
+	(*ValidateOpts)(nil),        // 9: moov.wire.v1.ValidateOpts
+}
+var file_wirepb_service_proto_depIdxs = []int32{
+	8,  // 0: moov.wire.v1.CreateFileRequest.file:type_name -> moov.wire.v1.File
+	9,  // 1: moov.wire.v1.CreateFileRequest.options:type_name -> moov.wire.v1.ValidateOpts
+	8,  // 2: moov.wire.v1.ValidateFileRequest.file:type_name -> moov.wire.v1.File
+	9,  // 3: moov.wire.v1.ValidateFileRequest.options:type_name -> moov.wire.v1.ValidateOpts
+	4,  // 4: moov.wire.v1.ValidationResult.errors:type_name -> moov.wire.v1.ValidationIssue
+	4,  // 5: moov.wire.v1.ValidationResult.warnings:type_name -> moov.wire.v1.ValidationIssue
+	8,  // 6: moov.wire.v1.ConvertFileRequest.file:type_name -> moov.wire.v1.File
+	9,  // 7: moov.wire.v1.ConvertFileRequest.options:type_name -> moov.wire.v1.ValidateOpts
+	0,  // 8: moov.wire.v1.ConvertFileRequest.format:type_name -> moov.wire.v1.Format
+	8,  // 9: moov.wire.v1.ConvertFileResponse.file:type_name -> moov.wire.v1.File
+	1,  // 10: moov.wire.v1.WireService.CreateFile:input_type -> moov.wire.v1.CreateFileRequest
+	2,  // 11: moov.wire.v1.WireService.GetFile:input_type -> moov.wire.v1.GetFileRequest
+	3,  // 12: moov.wire.v1.WireService.ValidateFile:input_type -> moov.wire.v1.ValidateFileRequest
+	6,  // 13: moov.wire.v1.WireService.ConvertFile:input_type -> moov.wire.v1.ConvertFileRequest
+	3,  // 14: moov.wire.v1.WireService.ValidateFiles:input_type -> moov.wire.v1.ValidateFileRequest
+	8,  // 15: moov.wire.v1.WireService.CreateFile:output_type -> moov.wire.v1.File
+	8,  // 16: moov.wire.v1.WireService.GetFile:output_type -> moov.wire.v1.File
+	5,  // 17: moov.wire.v1.WireService.ValidateFile:output_type -> moov.wire.v1.ValidationResult
+	7,  // 18: moov.wire.v1.WireService.ConvertFile:output_type -> moov.wire.v1.ConvertFileResponse
+	5,  // 19: moov.wire.v1.WireService.ValidateFiles:output_type -> moov.wire.v1.ValidationResult
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_wirepb_service_proto_init() }
+func file_wirepb_service_proto_init() {
+	if File_wirepb_service_proto != nil {
+		return
+	}
+	file_wirepb_wire_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_wirepb_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wirepb_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wirepb_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wirepb_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wirepb_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wirepb_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wirepb_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_wirepb_service_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*CreateFileRequest_File)(nil),
+		(*CreateFileRequest_Text)(nil),
+	}
+	file_wirepb_service_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*ValidateFileRequest_File)(nil),
+		(*ValidateFileRequest_Text)(nil),
+		(*ValidateFileRequest_FileId)(nil),
+	}
+	file_wirepb_service_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*ConvertFileRequest_File)(nil),
+		(*ConvertFileRequest_Text)(nil),
+	}
+	file_wirepb_service_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ConvertFileResponse_File)(nil),
+		(*ConvertFileResponse_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_wirepb_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wirepb_service_proto_goTypes,
+		DependencyIndexes: file_wirepb_service_proto_depIdxs,
+		EnumInfos:         file_wirepb_service_proto_enumTypes,
+		MessageInfos:      file_wirepb_service_proto_msgTypes,
+	}.Build()
+	File_wirepb_service_proto = out.File
+	file_wirepb_service_proto_rawDesc = nil
+	file_wirepb_service_proto_goTypes = nil
+	file_wirepb_service_proto_depIdxs = nil
+}
@@ -0,0 +1,308 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: wirepb/service.proto
+
+package wirepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	WireService_CreateFile_FullMethodName    = "/moov.wire.v1.WireService/CreateFile"
+	WireService_GetFile_FullMethodName       = "/moov.wire.v1.WireService/GetFile"
+	WireService_ValidateFile_FullMethodName  = "/moov.wire.v1.WireService/ValidateFile"
+	WireService_ConvertFile_FullMethodName   = "/moov.wire.v1.WireService/ConvertFile"
+	WireService_ValidateFiles_FullMethodName = "/moov.wire.v1.WireService/ValidateFiles"
+)
+
+// WireServiceClient is the client API for WireService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WireServiceClient interface {
+	// CreateFile validates and stores a file. Invalid files return INVALID_ARGUMENT with a
+	// google.rpc.BadRequest detail listing every error.
+	CreateFile(ctx context.Context, in *CreateFileRequest, opts ...grpc.CallOption) (*File, error)
+	// GetFile returns a stored file
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*File, error)
+	// ValidateFile validates a stored file, or one in the request without storing it
+	ValidateFile(ctx context.Context, in *ValidateFileRequest, opts ...grpc.CallOption) (*ValidationResult, error)
+	// ConvertFile converts a file between Fedwire text and a File without storing it
+	ConvertFile(ctx context.Context, in *ConvertFileRequest, opts ...grpc.CallOption) (*ConvertFileResponse, error)
+	// ValidateFiles validates a stream of files without storing them, returning a result for each
+	// request in the order they're sent
+	ValidateFiles(ctx context.Context, opts ...grpc.CallOption) (WireService_ValidateFilesClient, error)
+}
+
+type wireServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWireServiceClient(cc grpc.ClientConnInterface) WireServiceClient {
+	return &wireServiceClient{cc}
+}
+
+func (c *wireServiceClient) CreateFile(ctx context.Context, in *CreateFileRequest, opts ...grpc.CallOption) (*File, error) {
+	out := new(File)
+	err := c.cc.Invoke(ctx, WireService_CreateFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*File, error) {
+	out := new(File)
+	err := c.cc.Invoke(ctx, WireService_GetFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) ValidateFile(ctx context.Context, in *ValidateFileRequest, opts ...grpc.CallOption) (*ValidationResult, error) {
+	out := new(ValidationResult)
+	err := c.cc.Invoke(ctx, WireService_ValidateFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) ConvertFile(ctx context.Context, in *ConvertFileRequest, opts ...grpc.CallOption) (*ConvertFileResponse, error) {
+	out := new(ConvertFileResponse)
+	err := c.cc.Invoke(ctx, WireService_ConvertFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) ValidateFiles(ctx context.Context, opts ...grpc.CallOption) (WireService_ValidateFilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WireService_ServiceDesc.Streams[0], WireService_ValidateFiles_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &wireServiceValidateFilesClient{stream}
+	return x, nil
+}
+
+type WireService_ValidateFilesClient interface {
+	Send(*ValidateFileRequest) error
+	Recv() (*ValidationResult, error)
+	grpc.ClientStream
+}
+
+type wireServiceValidateFilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *wireServiceValidateFilesClient) Send(m *ValidateFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *wireServiceValidateFilesClient) Recv() (*ValidationResult, error) {
+	m := new(ValidationResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WireServiceServer is the server API for WireService service.
+// All implementations must embed UnimplementedWireServiceServer
+// for forward compatibility
+type WireServiceServer interface {
+	// CreateFile validates and stores a file. Invalid files return INVALID_ARGUMENT with a
+	// google.rpc.BadRequest detail listing every error.
+	CreateFile(context.Context, *CreateFileRequest) (*File, error)
+	// GetFile returns a stored file
+	GetFile(context.Context, *GetFileRequest) (*File, error)
+	// ValidateFile validates a stored file, or one in the request without storing it
+	ValidateFile(context.Context, *ValidateFileRequest) (*ValidationResult, error)
+	// ConvertFile converts a file between Fedwire text and a File without storing it
+	ConvertFile(context.Context, *ConvertFileRequest) (*ConvertFileResponse, error)
+	// ValidateFiles validates a stream of files without storing them, returning a result for each
+	// request in the order they're sent
+	ValidateFiles(WireService_ValidateFilesServer) error
+	mustEmbedUnimplementedWireServiceServer()
+}
+
+// UnimplementedWireServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWireServiceServer struct {
+}
+
+func (UnimplementedWireServiceServer) CreateFile(context.Context, *CreateFileRequest) (*File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFile not implemented")
+}
+func (UnimplementedWireServiceServer) GetFile(context.Context, *GetFileRequest) (*File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedWireServiceServer) ValidateFile(context.Context, *ValidateFileRequest) (*ValidationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateFile not implemented")
+}
+func (UnimplementedWireServiceServer) ConvertFile(context.Context, *ConvertFileRequest) (*ConvertFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertFile not implemented")
+}
+func (UnimplementedWireServiceServer) ValidateFiles(WireService_ValidateFilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateFiles not implemented")
+}
+func (UnimplementedWireServiceServer) mustEmbedUnimplementedWireServiceServer() {}
+
+// UnsafeWireServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WireServiceServer will
+// result in compilation errors.
+type UnsafeWireServiceServer interface {
+	mustEmbedUnimplementedWireServiceServer()
+}
+
+func RegisterWireServiceServer(s grpc.ServiceRegistrar, srv WireServiceServer) {
+	s.RegisterService(&WireService_ServiceDesc, srv)
+}
+
+func _WireService_CreateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).CreateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_CreateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).CreateFile(ctx, req.(*CreateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_GetFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).GetFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_ValidateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).ValidateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_ValidateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).ValidateFile(ctx, req.(*ValidateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_ConvertFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).ConvertFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_ConvertFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).ConvertFile(ctx, req.(*ConvertFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_ValidateFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WireServiceServer).ValidateFiles(&wireServiceValidateFilesServer{stream})
+}
+
+type WireService_ValidateFilesServer interface {
+	Send(*ValidationResult) error
+	Recv() (*ValidateFileRequest, error)
+	grpc.ServerStream
+}
+
+type wireServiceValidateFilesServer struct {
+	grpc.ServerStream
+}
+
+func (x *wireServiceValidateFilesServer) Send(m *ValidationResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *wireServiceValidateFilesServer) Recv() (*ValidateFileRequest, error) {
+	m := new(ValidateFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WireService_ServiceDesc is the grpc.ServiceDesc for WireService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WireService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moov.wire.v1.WireService",
+	HandlerType: (*WireServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFile",
+			Handler:    _WireService_CreateFile_Handler,
+		},
+		{
+			MethodName: "GetFile",
+			Handler:    _WireService_GetFile_Handler,
+		},
+		{
+			MethodName: "ValidateFile",
+			Handler:    _WireService_ValidateFile_Handler,
+		},
+		{
+			MethodName: "ConvertFile",
+			Handler:    _WireService_ConvertFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidateFiles",
+			Handler:       _WireService_ValidateFiles_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "wirepb/service.proto",
+}
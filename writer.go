@@ -185,7 +185,9 @@ func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 			return err
 		}
 	} else {
-		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+		if fwm.requireIMAD() {
+			return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+		}
 	}
 
 	if fwm.Amount != nil {
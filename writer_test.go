@@ -92,6 +92,24 @@ func TestInputMessageAccountabilityData_Mandatory(t *testing.T) {
 	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
 }
 
+func TestInputMessageAccountabilityData_SkipMandatory(t *testing.T) {
+	file := NewFile()
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.InputMessageAccountabilityData = nil
+	file.AddFEDWireMessage(fwm)
+
+	var buf bytes.Buffer
+	require.Error(t, NewWriter(&buf).Write(file))
+
+	// the tag is left out when it isn't mandatory
+	file.SetValidation(&ValidateOpts{SkipMandatoryIMAD: true})
+	buf.Reset()
+	require.NoError(t, NewWriter(&buf).Write(file))
+	require.NotContains(t, buf.String(), TagInputMessageAccountabilityData)
+}
+
 func TestAmount_Mandatory(t *testing.T) {
 	file := NewFile()
 	fwm := FEDWireMessage{}
@@ -1262,13 +1280,18 @@ func TestFEDWireMessageWriteCustomerTransferPlusRemittanceInformationStructured(
 	// Additional Remittance Data
 	prd := mockPrimaryRemittanceDocument()
 	fwm.PrimaryRemittanceDocument = prd
+	// remittance amounts must add up to the amount of the transfer
 	aap := mockActualAmountPaid()
+	aap.RemittanceAmount.Amount = "12345.67"
 	fwm.ActualAmountPaid = aap
 	gard := mockGrossAmountRemittanceDocument()
+	gard.RemittanceAmount.Amount = "12500.00"
 	fwm.GrossAmountRemittanceDocument = gard
 	nd := mockAmountNegotiatedDiscount()
+	nd.RemittanceAmount.Amount = "100.00"
 	fwm.AmountNegotiatedDiscount = nd
 	adj := mockAdjustment()
+	adj.RemittanceAmount.Amount = "54.33"
 	fwm.Adjustment = adj
 	drd := mockDateRemittanceDocument()
 	fwm.DateRemittanceDocument = drd